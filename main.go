@@ -5,11 +5,13 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 
 	"github.com/PagerDuty/terraform-provider-pagerduty/pagerduty"
 	pagerdutyplugin "github.com/PagerDuty/terraform-provider-pagerduty/pagerdutyplugin"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 )
 
 func main() {
@@ -33,7 +35,9 @@ func Serve() {
 	var serveOpts []tf5server.ServeOpt
 
 	address := "registry.terraform.io/pagerduty/pagerduty"
-	err = tf5server.Serve(address, muxServer.ProviderServer, serveOpts...)
+	err = tf5server.Serve(address, func() tfprotov5.ProviderServer {
+		return util.NewReadOnlyProviderServer(muxServer.ProviderServer())
+	}, serveOpts...)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,54 @@
+package pagerduty
+
+import (
+	"strings"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiErrorDiagnostics translates an error of the PagerDuty API into
+// diagnostics, reporting each field error of a validation failure on the
+// attribute of `resourceSchema` it refers to. Errors not coming from the API
+// are reported as is.
+func apiErrorDiagnostics(err error, resourceSchema map[string]*schema.Schema) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	details, ok := util.ParseAPIError(err)
+	if !ok {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	if details.Kind == util.APIErrorKindValidation {
+		attributes := make([]string, 0, len(resourceSchema))
+		for name := range resourceSchema {
+			attributes = append(attributes, name)
+		}
+		for _, msg := range details.Errors {
+			name, ok := util.MatchAPIErrorAttribute(msg, attributes)
+			if !ok {
+				continue
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       msg,
+				Detail:        strings.TrimSuffix(err.Error(), "\n\n"+details.Hint()),
+				AttributePath: cty.GetAttrPath(name),
+			})
+		}
+	}
+
+	if len(diags) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  strings.TrimSuffix(err.Error(), "\n\n"+details.Hint()),
+			Detail:   details.Hint(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,63 @@
+package pagerduty
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	validationErr := &pagerduty.Error{
+		ErrorResponse: &pagerduty.Response{
+			Response: &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Status:     http.StatusText(http.StatusBadRequest),
+				Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/users"}},
+			},
+		},
+		Code:    2001,
+		Message: "Invalid Input Provided",
+		Errors:  []interface{}{"Email has already been taken", "Job title is too long"},
+	}
+
+	diags := apiErrorDiagnostics(validationErr, resourcePagerDutyUser().Schema)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+	for i, name := range []string{"email", "job_title"} {
+		if !diags[i].AttributePath.Equals(cty.GetAttrPath(name)) {
+			t.Errorf("expected diagnostic %d on %s, got %#v", i, name, diags[i].AttributePath)
+		}
+	}
+
+	diags = apiErrorDiagnostics(validationErr, nil)
+	if len(diags) != 1 || diags[0].AttributePath != nil {
+		t.Errorf("expected a single diagnostic without attribute, got %v", diags)
+	}
+
+	diags = apiErrorDiagnostics(errors.New("boom"), resourcePagerDutyUser().Schema)
+	if len(diags) != 1 || diags[0].Summary != "boom" {
+		t.Errorf("expected the error as is, got %v", diags)
+	}
+
+	gone := resourcePagerDutyUser().TestResourceData()
+	gone.SetId("PUSER01")
+	validationErr.Code = 0
+	validationErr.ErrorResponse.Response.StatusCode = http.StatusForbidden
+	diags = apiErrorDiagnostics(handleNotFoundError(validationErr, gone), nil)
+	if len(diags) != 1 || !strings.HasPrefix(diags[0].Summary, "Error reading: PUSER01") || strings.Contains(diags[0].Summary, "credentials") || !strings.Contains(diags[0].Detail, "credentials") {
+		t.Errorf("expected the shared helpers to translate the error once, got %v", diags)
+	}
+	if err := genError(validationErr, gone); !strings.Contains(err.Error(), "credentials") {
+		t.Errorf("expected the hint in the error, got %q", err)
+	}
+
+	if diags := apiErrorDiagnostics(nil, nil); diags != nil {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+}
@@ -49,8 +49,20 @@ type Config struct {
 
 	ServiceRegion string
 
+	// Labels of the tags assigned to every taggable resource
+	DefaultTags []string
+
+	// Reject any request that would modify the PagerDuty account
+	ReadOnly bool
+
+	// Value of `deletion_protection` for resources not configuring it
+	DefaultDeletionProtection bool
+
 	client      *pagerduty.Client
 	slackClient *pagerduty.Client
+
+	// Abilities of the account and scopes granted to the provider
+	capabilities util.AccountCapabilities
 }
 
 const invalidCreds = `
@@ -84,6 +96,9 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	}
 	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
+	if c.ReadOnly {
+		httpClient.Transport = util.NewReadOnlyTransport(httpClient.Transport)
+	}
 
 	apiUrl := c.ApiUrl
 	if c.ApiUrlOverride != "" {
@@ -120,9 +135,11 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 
 			// Validate the credentials by calling the abilities endpoint,
 			// if we get a 401 response back we return an error to the user
-			if err := client.ValidateAuth(); err != nil {
+			abilities, _, err := client.Abilities.List()
+			if err != nil {
 				return retry.RetryableError(err)
 			}
+			c.capabilities.SetAbilities(abilities.Abilities)
 			return nil
 		})
 
@@ -138,6 +155,18 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 	return c.client, nil
 }
 
+// Capabilities returns the abilities of the account and the OAuth scopes
+// granted to the provider, as far as they are known. Abilities are only
+// known once the credentials have been validated.
+func (c *Config) Capabilities() *util.AccountCapabilities {
+	if c.AppOauthScopedTokenParams != nil {
+		if scopes, ok := util.GrantedScopes(c.AppOauthScopedTokenParams.ClientID); ok {
+			c.capabilities.SetScopes(scopes)
+		}
+	}
+	return &c.capabilities
+}
+
 func (c *Config) SlackClient() (*pagerduty.Client, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -161,6 +190,9 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	}
 	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
+	if c.ReadOnly {
+		httpClient.Transport = util.NewReadOnlyTransport(httpClient.Transport)
+	}
 
 	config := &pagerduty.Config{
 		BaseURL:    c.AppUrl,
@@ -0,0 +1,149 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// dataSourcePagerDutyEmailParserTest is the `pagerduty_email_parser_test`
+// data source, running a sample email through the email filters and parsers
+// of an integration.
+func dataSourcePagerDutyEmailParserTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyEmailParserTestRead,
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"service_integration"},
+			},
+			"service_integration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{"service"},
+				ExactlyOneOf:  []string{"service_integration", "integration"},
+				ConflictsWith: []string{"integration"},
+			},
+			"integration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"accepted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"matched_parser": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEmailParserTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var integration *pagerduty.Integration
+	if doc, ok := d.GetOk("integration"); ok {
+		var err error
+		if integration, err = decodeServiceIntegrationDocument(doc.(string)); err != nil {
+			return diag.Errorf("Invalid integration: %s", err)
+		}
+	} else {
+		var err error
+		if integration, err = fetchServiceIntegrationForEmailParserTest(ctx, meta, d.Get("service").(string), d.Get("service_integration").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	result, err := processServiceIntegrationEmail(integration, serviceIntegrationEmail{
+		Subject: d.Get("subject").(string),
+		Body:    d.Get("body").(string),
+		From:    d.Get("from").(string),
+	})
+	if err != nil {
+		return diag.Errorf("Error testing email: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("accepted", result.Accepted)
+	d.Set("action", result.Action)
+	d.Set("matched_parser", result.MatchedParser)
+	d.Set("values", result.Values)
+
+	return nil
+}
+
+// decodeServiceIntegrationDocument decodes a service integration as returned
+// by the API, either bare or wrapped in `integration`.
+func decodeServiceIntegrationDocument(doc string) (*pagerduty.Integration, error) {
+	var payload pagerduty.IntegrationPayload
+	if err := json.Unmarshal([]byte(doc), &payload); err != nil {
+		return nil, err
+	}
+	if payload.Integration != nil {
+		return payload.Integration, nil
+	}
+
+	var integration pagerduty.Integration
+	if err := json.Unmarshal([]byte(doc), &integration); err != nil {
+		return nil, err
+	}
+	return &integration, nil
+}
+
+func fetchServiceIntegrationForEmailParserTest(ctx context.Context, meta interface{}, serviceID, integrationID string) (*pagerduty.Integration, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var integration *pagerduty.Integration
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		log.Printf("[INFO] Reading PagerDuty service integration %s", integrationID)
+
+		i, _, err := client.Services.GetIntegration(serviceID, integrationID, nil)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		integration = i
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+	return integration, nil
+}
@@ -0,0 +1,72 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEmailParserTest_Inline(t *testing.T) {
+	n := "data.pagerduty_email_parser_test.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEmailParserTestInlineConfig("[ALERT] host=db-01 disk full"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(n, "accepted", "true"),
+					resource.TestCheckResourceAttr(n, "action", "trigger"),
+					resource.TestCheckResourceAttr(n, "matched_parser", "1"),
+					resource.TestCheckResourceAttr(n, "values.incident_key", "db-01"),
+				),
+			},
+			{
+				Config: testAccDataSourcePagerDutyEmailParserTestInlineConfig("Weekly newsletter"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(n, "accepted", "false"),
+					resource.TestCheckResourceAttr(n, "action", "discard"),
+					resource.TestCheckResourceAttr(n, "values.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEmailParserTestInlineConfig(subject string) string {
+	return `
+data "pagerduty_email_parser_test" "test" {
+  subject = "` + subject + `"
+  body    = "Disk usage at 95%"
+  from    = "alerts@monitoring.test"
+
+  integration = jsonencode({
+    email_incident_creation = "use_rules"
+    email_filter_mode       = "and-rules-email"
+    email_parsing_fallback  = "open_new_incident"
+    email_filters = [{
+      subject_mode    = "match"
+      subject_regex   = "^\\[ALERT\\]"
+      body_mode       = "always"
+      from_email_mode = "always"
+    }]
+    email_parsers = [{
+      id     = 1
+      action = "trigger"
+      match_predicate = {
+        type     = "all"
+        children = [{ type = "contains", part = "subject", matcher = "disk full" }]
+      }
+      value_extractors = [{
+        type         = "between"
+        part         = "subject"
+        starts_after = "host="
+        ends_before  = " "
+        value_name   = "incident_key"
+      }]
+    }]
+  })
+}
+`
+}
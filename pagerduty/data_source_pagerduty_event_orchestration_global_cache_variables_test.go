@@ -0,0 +1,86 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEventOrchestrationGlobalCacheVariables_Basic(t *testing.T) {
+	on := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf_global_cache_variable_%s", acctest.RandString(5))
+	n := "data.pagerduty_event_orchestration_global_cache_variables.all"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEventOrchestrationGlobalCacheVariablesConfig(on, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(n, "id", "pagerduty_event_orchestration.orch", "id"),
+					resource.TestCheckResourceAttr(n, "cache_variables.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(n, "cache_variables.*", map[string]string{
+						"name":                        name + "_count",
+						"disabled":                    "false",
+						"configuration.0.type":        "trigger_event_count",
+						"configuration.0.ttl_seconds": "60",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(n, "cache_variables.*", map[string]string{
+						"name":                   name + "_host",
+						"disabled":               "true",
+						"condition.0.expression": "event.source exists",
+						"configuration.0.type":   "recent_value",
+						"configuration.0.source": "event.source",
+						"configuration.0.regex":  ".*",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEventOrchestrationGlobalCacheVariablesConfig(on, name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_event_orchestration" "orch" {
+  name = "%[1]s"
+}
+
+resource "pagerduty_event_orchestration_global_cache_variable" "count" {
+  event_orchestration = pagerduty_event_orchestration.orch.id
+  name = "%[2]s_count"
+
+  configuration {
+    type = "trigger_event_count"
+    ttl_seconds = 60
+  }
+}
+
+resource "pagerduty_event_orchestration_global_cache_variable" "host" {
+  event_orchestration = pagerduty_event_orchestration.orch.id
+  name = "%[2]s_host"
+  disabled = true
+
+  condition {
+    expression = "event.source exists"
+  }
+
+  configuration {
+    type = "recent_value"
+    source = "event.source"
+    regex = ".*"
+  }
+}
+
+data "pagerduty_event_orchestration_global_cache_variables" "all" {
+  event_orchestration = pagerduty_event_orchestration.orch.id
+
+  depends_on = [
+    pagerduty_event_orchestration_global_cache_variable.count,
+    pagerduty_event_orchestration_global_cache_variable.host,
+  ]
+}
+`, on, name)
+}
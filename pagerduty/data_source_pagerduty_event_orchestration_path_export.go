@@ -0,0 +1,328 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// eventOrchestrationPathExport describes how a type of path is rendered as
+// the resource managing it.
+type eventOrchestrationPathExport struct {
+	resourceType    string
+	parentAttr      string
+	schema          func() map[string]*schema.Schema
+	flattenSets     func([]*pagerduty.EventOrchestrationPathSet) []interface{}
+	flattenCatchAll func(*pagerduty.EventOrchestrationPathCatchAll) []map[string]interface{}
+}
+
+var eventOrchestrationPathExports = map[string]eventOrchestrationPathExport{
+	"global": {
+		resourceType:    "pagerduty_event_orchestration_global",
+		parentAttr:      "event_orchestration",
+		schema:          func() map[string]*schema.Schema { return resourcePagerDutyEventOrchestrationPathGlobal().Schema },
+		flattenSets:     flattenGlobalPathSets,
+		flattenCatchAll: flattenGlobalPathCatchAll,
+	},
+	"router": {
+		resourceType:    "pagerduty_event_orchestration_router",
+		parentAttr:      "event_orchestration",
+		schema:          func() map[string]*schema.Schema { return resourcePagerDutyEventOrchestrationPathRouter().Schema },
+		flattenSets:     flattenSets,
+		flattenCatchAll: flattenCatchAll,
+	},
+	"service": {
+		resourceType:    "pagerduty_event_orchestration_service",
+		parentAttr:      "service",
+		schema:          func() map[string]*schema.Schema { return resourcePagerDutyEventOrchestrationPathService().Schema },
+		flattenSets:     flattenServicePathSets,
+		flattenCatchAll: flattenServicePathCatchAll,
+	},
+	"unrouted": {
+		resourceType:    "pagerduty_event_orchestration_unrouted",
+		parentAttr:      "event_orchestration",
+		schema:          func() map[string]*schema.Schema { return resourcePagerDutyEventOrchestrationPathUnrouted().Schema },
+		flattenSets:     flattenUnroutedSets,
+		flattenCatchAll: flattenUnroutedCatchAll,
+	},
+}
+
+// hclIdentifierRegexp matches the names allowed for resources.
+var hclIdentifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// eventOrchestrationPathServerFields are the fields of a path set by the API.
+var eventOrchestrationPathServerFields = []string{"type", "self", "parent", "version", "created_at", "created_by", "updated_at", "updated_by"}
+
+func dataSourcePagerDutyEventOrchestrationPathExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyEventOrchestrationPathExportRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"global", "router", "service", "unrouted"}, false),
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "this",
+				ValidateFunc: validation.StringMatch(hclIdentifierRegexp, "must be a valid Terraform resource name"),
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hcl": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEventOrchestrationPathExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathType := d.Get("type").(string)
+	parent := d.Get("parent").(string)
+	export := eventOrchestrationPathExports[pathType]
+
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+	var path *pagerduty.EventOrchestrationPath
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		p, _, err := client.EventOrchestrationPaths.GetContext(ctx, parent, pathType)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		path = p
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+	if path == nil {
+		return diag.Errorf("Unable to find the Event Orchestration Path of type %s for %s", pathType, parent)
+	}
+
+	document, err := normalizeEventOrchestrationPathExport(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		export.parentAttr: parent,
+		"set":             export.flattenSets(path.Sets),
+	}
+	if path.CatchAll != nil {
+		values["catch_all"] = export.flattenCatchAll(path.CatchAll)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", pathType, parent))
+	d.Set("json", document)
+	d.Set("hcl", renderEventOrchestrationPathHCL(export, d.Get("resource_name").(string), values))
+
+	return nil
+}
+
+// normalizeEventOrchestrationPathExport encodes a path without the fields set
+// by the API, with its keys sorted.
+func normalizeEventOrchestrationPathExport(path *pagerduty.EventOrchestrationPath) (string, error) {
+	b, err := json.Marshal(path)
+	if err != nil {
+		return "", err
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(b, &document); err != nil {
+		return "", err
+	}
+	for _, k := range eventOrchestrationPathServerFields {
+		delete(document, k)
+	}
+	b, err = json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// renderEventOrchestrationPathHCL renders the flattened values of a path as
+// the resource block managing it. Computed attributes and values equal to
+// their default are left out, like Terraform would read them back.
+func renderEventOrchestrationPathHCL(export eventOrchestrationPathExport, name string, values map[string]interface{}) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "resource %q %q {\n", export.resourceType, name)
+	writeHCLBody(&b, export.schema(), values, 1, export.parentAttr)
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func writeHCLBody(b *strings.Builder, s map[string]*schema.Schema, values map[string]interface{}, depth int, first ...string) {
+	indent := strings.Repeat("  ", depth)
+
+	var attrs, blocks []string
+	for k, v := range values {
+		sch, ok := s[k]
+		if !ok || (sch.Computed && !sch.Optional && !sch.Required) {
+			continue
+		}
+		if _, isBlock := sch.Elem.(*schema.Resource); isBlock {
+			if len(hclBlockItems(v)) > 0 {
+				blocks = append(blocks, k)
+			}
+			continue
+		}
+		if !isHCLDefaultValue(sch, v) {
+			attrs = append(attrs, k)
+		}
+	}
+	sortHCLKeys(attrs, first)
+	sort.Strings(blocks)
+
+	width := 0
+	for _, k := range attrs {
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	for _, k := range attrs {
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, k, hclValue(values[k]))
+	}
+
+	for i, k := range blocks {
+		if i > 0 || len(attrs) > 0 {
+			b.WriteString("\n")
+		}
+		elem := s[k].Elem.(*schema.Resource)
+		for j, item := range hclBlockItems(values[k]) {
+			if j > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(b, "%s%s {\n", indent, k)
+			writeHCLBody(b, elem.Schema, item, depth+1, "id", "label")
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+}
+
+// sortHCLKeys sorts keys alphabetically, the `first` ones leading.
+func sortHCLKeys(keys []string, first []string) {
+	rank := func(k string) int {
+		for i, f := range first {
+			if f == k {
+				return i
+			}
+		}
+		return len(first)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if ri, rj := rank(keys[i]), rank(keys[j]); ri != rj {
+			return ri < rj
+		}
+		return keys[i] < keys[j]
+	})
+}
+
+func hclBlockItems(v interface{}) []map[string]interface{} {
+	var items []map[string]interface{}
+	switch l := v.(type) {
+	case []map[string]interface{}:
+		items = l
+	case []interface{}:
+		for _, item := range l {
+			if m, ok := item.(map[string]interface{}); ok {
+				items = append(items, m)
+			}
+		}
+	}
+	return items
+}
+
+func isHCLDefaultValue(sch *schema.Schema, v interface{}) bool {
+	if sch.Default != nil {
+		return v == sch.Default
+	}
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case bool:
+		return !value
+	case int:
+		return value == 0
+	case *int:
+		return value == nil
+	case []interface{}:
+		return len(value) == 0
+	case []string:
+		return len(value) == 0
+	}
+	return false
+}
+
+func hclValue(v interface{}) string {
+	switch value := v.(type) {
+	case string:
+		return hclString(value)
+	case bool:
+		return strconv.FormatBool(value)
+	case int:
+		return strconv.Itoa(value)
+	case *int:
+		return strconv.Itoa(*value)
+	case []string:
+		items := make([]string, len(value))
+		for i, item := range value {
+			items[i] = hclString(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case []interface{}:
+		items := make([]string, len(value))
+		for i, item := range value {
+			items[i] = hclValue(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	return hclString(fmt.Sprint(v))
+}
+
+var hclStringReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+// hclString quotes a string, escaping the template sequences of HCL.
+func hclString(s string) string {
+	return `"` + hclStringReplacer.Replace(s) + `"`
+}
@@ -0,0 +1,159 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestRenderEventOrchestrationPathHCL(t *testing.T) {
+	suspend := 60
+	path := &pagerduty.EventOrchestrationPath{
+		Sets: []*pagerduty.EventOrchestrationPathSet{
+			{
+				ID: "start",
+				Rules: []*pagerduty.EventOrchestrationPathRule{
+					{
+						ID:    "abc123",
+						Label: `Disk "full" on ${host}`,
+						Conditions: []*pagerduty.EventOrchestrationPathRuleCondition{
+							{Expression: "event.summary matches part 'disk'"},
+						},
+						Actions: &pagerduty.EventOrchestrationPathRuleActions{
+							Priority: "P0IN2KQ",
+							Suspend:  &suspend,
+							Variables: []*pagerduty.EventOrchestrationPathActionVariables{
+								{Name: "host", Path: "event.source", Type: "regex", Value: "(.*)"},
+							},
+						},
+					},
+				},
+			},
+		},
+		CatchAll: &pagerduty.EventOrchestrationPathCatchAll{
+			Actions: &pagerduty.EventOrchestrationPathRuleActions{Suppress: true},
+		},
+	}
+
+	export := eventOrchestrationPathExports["service"]
+	values := map[string]interface{}{
+		"service":   "PSERVICE",
+		"set":       export.flattenSets(path.Sets),
+		"catch_all": export.flattenCatchAll(path.CatchAll),
+	}
+	expected := `resource "pagerduty_event_orchestration_service" "db" {
+  service = "PSERVICE"
+
+  catch_all {
+    actions {
+      suppress = true
+    }
+  }
+
+  set {
+    id = "start"
+
+    rule {
+      label = "Disk \"full\" on $${host}"
+
+      actions {
+        priority = "P0IN2KQ"
+        suspend  = 60
+
+        variable {
+          name  = "host"
+          path  = "event.source"
+          type  = "regex"
+          value = "(.*)"
+        }
+      }
+
+      condition {
+        expression = "event.summary matches part 'disk'"
+      }
+    }
+  }
+}
+`
+	if got := renderEventOrchestrationPathHCL(export, "db", values); got != expected {
+		t.Errorf("unexpected HCL:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	document, err := normalizeEventOrchestrationPathExport(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(document, `"parent"`) || !strings.Contains(document, `"id": "abc123"`) {
+		t.Errorf("unexpected JSON document:\n%s", document)
+	}
+}
+
+func TestAccDataSourcePagerDutyEventOrchestrationPathExport_Router(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	n := "data.pagerduty_event_orchestration_path_export.router"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEventOrchestrationPathExportConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(n, "hcl", regexp.MustCompile(`^resource "pagerduty_event_orchestration_router" "router" \{\n  event_orchestration = "`)),
+					resource.TestMatchResourceAttr(n, "hcl", regexp.MustCompile(`label = "Route disk alerts"`)),
+					resource.TestMatchResourceAttr(n, "hcl", regexp.MustCompile(`route_to = "unrouted"`)),
+					resource.TestMatchResourceAttr(n, "json", regexp.MustCompile(`"label": "Route disk alerts"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEventOrchestrationPathExportConfig(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "pagerduty_service" "svc" {
+  name = "%[2]s"
+  escalation_policy = pagerduty_escalation_policy.ep.id
+}
+
+resource "pagerduty_event_orchestration" "orch" {
+  name = "%[2]s"
+}
+
+resource "pagerduty_event_orchestration_router" "router" {
+  event_orchestration = pagerduty_event_orchestration.orch.id
+
+  set {
+    id = "start"
+    rule {
+      label = "Route disk alerts"
+      condition {
+        expression = "event.summary matches part 'disk'"
+      }
+      actions {
+        route_to = pagerduty_service.svc.id
+      }
+    }
+  }
+
+  catch_all {
+    actions {
+      route_to = "unrouted"
+    }
+  }
+}
+
+data "pagerduty_event_orchestration_path_export" "router" {
+  type          = "router"
+  parent        = pagerduty_event_orchestration_router.router.event_orchestration
+  resource_name = "router"
+}
+`, EPResources, name)
+}
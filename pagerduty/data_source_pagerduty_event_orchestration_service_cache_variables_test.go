@@ -0,0 +1,87 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEventOrchestrationServiceCacheVariables_Basic(t *testing.T) {
+	sn := fmt.Sprintf("tf-service-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf_service_cache_variable_%s", acctest.RandString(5))
+	n := "data.pagerduty_event_orchestration_service_cache_variables.all"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEventOrchestrationServiceCacheVariablesConfig(sn, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(n, "id", "pagerduty_service.svc", "id"),
+					resource.TestCheckResourceAttr(n, "cache_variables.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(n, "cache_variables.*", map[string]string{
+						"name":                        name + "_count",
+						"disabled":                    "false",
+						"configuration.0.type":        "trigger_event_count",
+						"configuration.0.ttl_seconds": "60",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(n, "cache_variables.*", map[string]string{
+						"name":                   name + "_host",
+						"condition.0.expression": "event.source exists",
+						"configuration.0.type":   "recent_value",
+						"configuration.0.source": "event.source",
+						"configuration.0.regex":  ".*",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEventOrchestrationServiceCacheVariablesConfig(sn, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "pagerduty_service" "svc" {
+  name = "%[2]s"
+  escalation_policy = pagerduty_escalation_policy.ep.id
+}
+
+resource "pagerduty_event_orchestration_service_cache_variable" "count" {
+  service = pagerduty_service.svc.id
+  name = "%[3]s_count"
+
+  configuration {
+    type = "trigger_event_count"
+    ttl_seconds = 60
+  }
+}
+
+resource "pagerduty_event_orchestration_service_cache_variable" "host" {
+  service = pagerduty_service.svc.id
+  name = "%[3]s_host"
+
+  condition {
+    expression = "event.source exists"
+  }
+
+  configuration {
+    type = "recent_value"
+    source = "event.source"
+    regex = ".*"
+  }
+}
+
+data "pagerduty_event_orchestration_service_cache_variables" "all" {
+  service = pagerduty_service.svc.id
+
+  depends_on = [
+    pagerduty_event_orchestration_service_cache_variable.count,
+    pagerduty_event_orchestration_service_cache_variable.host,
+  ]
+}
+`, EPResources, sn, name)
+}
@@ -0,0 +1,287 @@
+package pagerduty
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyEventOrchestrationSimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyEventOrchestrationSimulationRead,
+		Schema: map[string]*schema.Schema{
+			"event": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"event_orchestration": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"global_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"router_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"unrouted_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"service_paths": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cache_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"matched_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"set": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"route_to": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dropped": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"priority": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"escalation_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"annotations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"suppress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"suspend": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"extractions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEventOrchestrationSimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var event map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("event").(string)), &event); err != nil {
+		return diag.Errorf("Invalid event: %s", err)
+	}
+
+	in := eventOrchestrationSimulationInput{
+		Event:          event,
+		CacheVariables: d.Get("cache_variables").(map[string]interface{}),
+	}
+
+	oid := d.Get("event_orchestration").(string)
+	getPath := func(attr, id, pathType string) (*pagerduty.EventOrchestrationPath, error) {
+		if doc, ok := d.GetOk(attr); ok {
+			return decodeEventOrchestrationPathDocument(doc.(string))
+		}
+		if oid == "" {
+			return nil, nil
+		}
+		return fetchEventOrchestrationPathForSimulation(ctx, meta, id, pathType)
+	}
+
+	var err error
+	if in.Global, err = getPath("global_path", oid, pagerduty.PathTypeGlobal); err != nil {
+		return diag.Errorf("Invalid global path: %s", err)
+	}
+	if in.Router, err = getPath("router_path", oid, pagerduty.PathTypeRouter); err != nil {
+		return diag.Errorf("Invalid router path: %s", err)
+	}
+	if in.Unrouted, err = getPath("unrouted_path", oid, pagerduty.PathTypeUnrouted); err != nil {
+		return diag.Errorf("Invalid unrouted path: %s", err)
+	}
+
+	servicePaths := d.Get("service_paths").(map[string]interface{})
+	in.Service = func(serviceID string) (*pagerduty.EventOrchestrationPath, error) {
+		if doc, ok := servicePaths[serviceID]; ok {
+			path, err := decodeEventOrchestrationPathDocument(doc.(string))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid path of service %s: %w", serviceID, err)
+			}
+			return path, nil
+		}
+		if oid == "" {
+			return nil, nil
+		}
+		return fetchEventOrchestrationPathForSimulation(ctx, meta, serviceID, pagerduty.PathTypeService)
+	}
+
+	result, err := simulateEventOrchestration(in)
+	if err != nil {
+		return diag.Errorf("Error simulating event: %s", err)
+	}
+
+	d.SetId(eventOrchestrationSimulationID(d))
+	values := map[string]interface{}{
+		"matched_rule":      flattenEventOrchestrationSimulationMatches(result.Matches),
+		"route_to":          result.RouteTo,
+		"dropped":           result.Dropped,
+		"severity":          result.Severity,
+		"priority":          result.Priority,
+		"event_action":      result.EventAction,
+		"escalation_policy": result.EscalationPolicy,
+		"annotations":       result.Annotations,
+		"suppress":          result.Suppress,
+		"suspend":           result.Suspend,
+		"variables":         result.Variables,
+		"extractions":       result.Extractions,
+		"warnings":          result.Warnings,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("Error setting %s: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+// eventOrchestrationSimulationID identifies a simulation by the
+// orchestration it runs against and a hash of its input, so that reading it
+// again with the same input keeps the same ID.
+func eventOrchestrationSimulationID(d *schema.ResourceData) string {
+	input := map[string]interface{}{}
+	for _, k := range []string{"event", "global_path", "router_path", "unrouted_path", "service_paths", "cache_variables"} {
+		input[k] = d.Get(k)
+	}
+	b, _ := json.Marshal(input)
+	hash := fmt.Sprintf("%x", sha256.Sum256(b))
+
+	if oid := d.Get("event_orchestration").(string); oid != "" {
+		return fmt.Sprintf("%s:%s", oid, hash)
+	}
+	return hash
+}
+
+// decodeEventOrchestrationPathDocument decodes an orchestration path as
+// returned by the API, either bare or wrapped in `orchestration_path`.
+func decodeEventOrchestrationPathDocument(doc string) (*pagerduty.EventOrchestrationPath, error) {
+	var payload pagerduty.EventOrchestrationPathPayload
+	if err := json.Unmarshal([]byte(doc), &payload); err != nil {
+		return nil, err
+	}
+	if payload.OrchestrationPath != nil {
+		return payload.OrchestrationPath, nil
+	}
+
+	var path pagerduty.EventOrchestrationPath
+	if err := json.Unmarshal([]byte(doc), &path); err != nil {
+		return nil, err
+	}
+	return &path, nil
+}
+
+func fetchEventOrchestrationPathForSimulation(ctx context.Context, meta interface{}, id, pathType string) (*pagerduty.EventOrchestrationPath, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var path *pagerduty.EventOrchestrationPath
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for %s", pathType, id)
+
+		p, _, err := client.EventOrchestrationPaths.GetContext(ctx, id, pathType)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		path = p
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+	return path, nil
+}
+
+func flattenEventOrchestrationSimulationMatches(matches []eventOrchestrationSimulationMatch) []interface{} {
+	var result []interface{}
+	for _, m := range matches {
+		result = append(result, map[string]interface{}{
+			"path_type": m.PathType,
+			"service":   m.Parent,
+			"set":       m.Set,
+			"rule":      m.Rule,
+		})
+	}
+	return result
+}
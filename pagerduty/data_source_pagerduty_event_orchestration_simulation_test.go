@@ -0,0 +1,108 @@
+package pagerduty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestEventOrchestrationSimulationID(t *testing.T) {
+	r := dataSourcePagerDutyEventOrchestrationSimulation()
+	simulationID := func(raw map[string]interface{}) string {
+		return eventOrchestrationSimulationID(schema.TestResourceDataRaw(t, r.Schema, raw))
+	}
+
+	raw := map[string]interface{}{
+		"event":               `{"severity": "critical"}`,
+		"event_orchestration": "E01",
+		"service_paths":       map[string]interface{}{"PSERVICE": `{"sets": []}`},
+	}
+	id := simulationID(raw)
+	if !strings.HasPrefix(id, "E01:") {
+		t.Errorf("expected an ID prefixed with the orchestration, got %s", id)
+	}
+	if again := simulationID(raw); again != id {
+		t.Errorf("expected the same ID for the same input, got %s and %s", id, again)
+	}
+
+	raw["event"] = `{"severity": "info"}`
+	if changed := simulationID(raw); changed == id {
+		t.Errorf("expected another ID for another event, got %s", changed)
+	}
+}
+
+func TestAccDataSourcePagerDutyEventOrchestrationSimulation_Inline(t *testing.T) {
+	n := "data.pagerduty_event_orchestration_simulation.sim"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEventOrchestrationSimulationInlineConfig("critical"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(n, "route_to", "PSERVICE"),
+					resource.TestCheckResourceAttr(n, "matched_rule.#", "3"),
+					resource.TestCheckResourceAttr(n, "matched_rule.0.path_type", "global"),
+					resource.TestCheckResourceAttr(n, "matched_rule.0.rule", "catch_all"),
+					resource.TestCheckResourceAttr(n, "matched_rule.1.rule", "to-service"),
+					resource.TestCheckResourceAttr(n, "matched_rule.2.service", "PSERVICE"),
+					resource.TestCheckResourceAttr(n, "priority", "P1"),
+					resource.TestCheckResourceAttr(n, "variables.host", "db-01"),
+				),
+			},
+			{
+				Config: testAccDataSourcePagerDutyEventOrchestrationSimulationInlineConfig("info"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(n, "route_to", "unrouted"),
+					resource.TestCheckResourceAttr(n, "matched_rule.2.path_type", "unrouted"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEventOrchestrationSimulationInlineConfig(severity string) string {
+	return `
+data "pagerduty_event_orchestration_simulation" "sim" {
+  event = jsonencode({
+    event_action = "trigger"
+    payload = {
+      summary  = "Disk full on db-01"
+      source   = "db-01"
+      severity = "` + severity + `"
+    }
+  })
+
+  router_path = jsonencode({
+    sets = [{
+      id = "start"
+      rules = [{
+        id         = "to-service"
+        conditions = [{ expression = "event.severity matches 'critical'" }]
+        actions    = { route_to = "PSERVICE" }
+      }]
+    }]
+    catch_all = { actions = { route_to = "unrouted" } }
+  })
+
+  service_paths = {
+    PSERVICE = jsonencode({
+      sets = [{
+        id = "start"
+        rules = [{
+          id         = "db"
+          conditions = [{ expression = "event.source matches part 'db-'" }]
+          actions = {
+            priority  = "P1"
+            variables = [{ name = "host", path = "event.source", type = "regex", value = "(db-\\d+)" }]
+          }
+        }]
+      }]
+    })
+  }
+}
+`
+}
@@ -0,0 +1,114 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// desiredDefaultTags returns the labels a taggable resource should carry
+// according to the provider's `default_tags` argument.
+func desiredDefaultTags(meta interface{}, ignore bool) *schema.Set {
+	set := schema.NewSet(schema.HashString, nil)
+	if ignore {
+		return set
+	}
+	for _, label := range meta.(*Config).DefaultTags {
+		set.Add(label)
+	}
+	return set
+}
+
+// customizeDefaultTagsDiff plans `tags_all` so changes on the provider's
+// `default_tags` produce a diff on every resource they apply to.
+func customizeDefaultTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	desired := desiredDefaultTags(meta, diff.Get("ignore_default_tags").(bool))
+
+	current, ok := diff.Get("tags_all").(*schema.Set)
+	if ok && current.Equal(desired) {
+		return nil
+	}
+	return diff.SetNew("tags_all", desired)
+}
+
+// applyDefaultTags assigns and removes tags on an entity so the assigned
+// default tags match the planned `tags_all`. Tags missing on the account are
+// created by the API when assigned by label.
+func applyDefaultTags(client *pagerduty.Client, entityType string, d *schema.ResourceData) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, n := d.GetChange("tags_all")
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	add := expandStringList(ns.Difference(os).List())
+	remove := os.Difference(ns)
+
+	assignments := &pagerduty.TagAssignments{}
+	for _, label := range add {
+		assignments.Add = append(assignments.Add, &pagerduty.TagAssignment{Type: "tag", Label: label})
+	}
+
+	if remove.Len() > 0 {
+		resp, _, err := client.Tags.ListTagsForEntity(entityType, d.Id())
+		if err != nil {
+			return err
+		}
+		for _, tag := range resp.Tags {
+			if remove.Contains(tag.Label) {
+				assignments.Remove = append(assignments.Remove, &pagerduty.TagAssignment{Type: "tag_reference", TagID: tag.ID})
+			}
+		}
+	}
+
+	if len(assignments.Add) == 0 && len(assignments.Remove) == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Updating default tags of PagerDuty %s %s", entityType, d.Id())
+
+	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+		if _, err := client.Tags.Assign(entityType, d.Id(), assignments); err != nil {
+			if isErrCode(err, 400) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+// readDefaultTags refreshes `tags_all` with the managed default tags that are
+// still assigned to the entity. No request is made when the resource doesn't
+// manage any default tag.
+func readDefaultTags(client *pagerduty.Client, entityType string, d *schema.ResourceData, meta interface{}) error {
+	managed := desiredDefaultTags(meta, d.Get("ignore_default_tags").(bool))
+	if current, ok := d.Get("tags_all").(*schema.Set); ok {
+		managed = managed.Union(current)
+	}
+
+	if managed.Len() == 0 {
+		return d.Set("tags_all", managed)
+	}
+
+	resp, _, err := client.Tags.ListTagsForEntity(entityType, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading tags of %s %s: %w", entityType, d.Id(), err)
+	}
+
+	assigned := schema.NewSet(schema.HashString, nil)
+	for _, tag := range resp.Tags {
+		if managed.Contains(tag.Label) {
+			assigned.Add(tag.Label)
+		}
+	}
+
+	return d.Set("tags_all", assigned)
+}
@@ -0,0 +1,30 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customizeDeletionProtectionDiff plans `deletion_protection` with the
+// provider's `default_deletion_protection` when the resource doesn't
+// configure it explicitly.
+func customizeDeletionProtectionDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	raw := diff.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || !raw.GetAttr("deletion_protection").IsNull() {
+		return nil
+	}
+
+	return diff.SetNew("deletion_protection", meta.(*Config).DefaultDeletionProtection)
+}
+
+// checkDeletionProtection returns an error when a resource can't be deleted
+// because its `deletion_protection` is enabled.
+func checkDeletionProtection(d *schema.ResourceData, resourceType string) error {
+	if !d.Get("deletion_protection").(bool) {
+		return nil
+	}
+
+	return fmt.Errorf("cannot delete %s %s while deletion_protection is enabled. Set `deletion_protection = false` and apply the change before destroying it", resourceType, d.Id())
+}
@@ -2,9 +2,12 @@ package pagerduty
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
+	"regexp/syntax"
 	"strings"
 	"time"
 
@@ -79,6 +82,35 @@ var dataSourceEventOrchestrationCacheVariableConfigurationSchema = map[string]*s
 	},
 }
 
+var dataSourceEventOrchestrationCacheVariablesSchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"condition": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: dataSourceEventOrchestrationCacheVariableConditionSchema,
+		},
+	},
+	"configuration": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: dataSourceEventOrchestrationCacheVariableConfigurationSchema,
+		},
+	},
+	"disabled": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+}
+
 func checkEventOrchestrationCacheVariableConfiguration(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
 	c := diff.Get("condition").([]interface{})
 	t := diff.Get("configuration.0.type").(string)
@@ -119,6 +151,25 @@ func checkEventOrchestrationCacheVariableConfiguration(context context.Context,
 		errors := formatErrors([]string{missingAttrErr, unsupportedAttrErr})
 		return fmt.Errorf("Invalid configuration: %s when type is %s", errors, t)
 	}
+
+	// PagerDuty compiles regexes with RE2, as Go does.
+	if r != "" && diff.NewValueKnown("configuration.0.regex") {
+		if _, err := regexp.Compile(r); err != nil {
+			return fmt.Errorf("Invalid configuration in configuration.0.regex: %s", err)
+		}
+	}
+	for i := range c {
+		attr := fmt.Sprintf("condition.%d.expression", i)
+		if !diff.NewValueKnown(attr) {
+			continue
+		}
+		// Only the regexes of `matches regex` conditions are checked, other
+		// errors may come from PCL the local parser doesn't support.
+		var syntaxErr *syntax.Error
+		if _, err := parsePCL(diff.Get(attr).(string)); errors.As(err, &syntaxErr) {
+			return fmt.Errorf("Invalid configuration in %s: %s", attr, err)
+		}
+	}
 	return nil
 }
 
@@ -541,3 +592,47 @@ func getEventOrchestrationCacheVariableByName(ctx context.Context, d *schema.Res
 
 	return diags
 }
+
+func dataSourceEventOrchestrationCacheVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}, cacheVariableType string) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oid := d.Get(getIdentifier(cacheVariableType)).(string)
+
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		log.Printf("[INFO] Listing Cache Variables for PagerDuty Event Orchestration '%s'", oid)
+
+		resp, _, err := client.EventOrchestrationCacheVariables.List(ctx, cacheVariableType, oid)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			return retry.RetryableError(err)
+		}
+
+		var cacheVariables []interface{}
+		for _, cv := range resp.CacheVariables {
+			cacheVariables = append(cacheVariables, map[string]interface{}{
+				"id":            cv.ID,
+				"name":          cv.Name,
+				"disabled":      cv.Disabled,
+				"condition":     flattenEventOrchestrationCacheVariableConditions(cv.Conditions),
+				"configuration": flattenEventOrchestrationCacheVariableConfiguration(cv.Configuration),
+			})
+		}
+
+		d.SetId(oid)
+		d.Set("cache_variables", cacheVariables)
+
+		return nil
+	})
+
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+
+	return nil
+}
@@ -112,30 +112,6 @@ var eventOrchestrationAutomationActionSchema = map[string]*schema.Schema{
 	},
 }
 
-func invalidExtractionRegexTemplateNilConfig() string {
-	return `
-		extraction {
-			target = "event.summary"
-		}`
-}
-
-func invalidExtractionRegexTemplateValConfig() string {
-	return `
-		extraction {
-			regex = ".*"
-			template = "hi"
-			target = "event.summary"
-		}`
-}
-
-func invalidExtractionRegexNilSourceConfig() string {
-	return `
-		extraction {
-			regex = ".*"
-			target = "event.summary"
-		}`
-}
-
 func validateEventOrchestrationPathSeverity() schema.SchemaValidateDiagFunc {
 	return validateValueDiagFunc([]string{
 		"info",
@@ -0,0 +1,373 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// pclExpr is a parsed PagerDuty Condition Language expression, as used in
+// the conditions of Event Orchestration rules.
+// https://developer.pagerduty.com/docs/ZG9jOjM1NTE0MDc0-pcl-overview
+type pclExpr interface {
+	eval(fields map[string]interface{}) bool
+}
+
+type pclAnd struct{ left, right pclExpr }
+
+func (e *pclAnd) eval(f map[string]interface{}) bool { return e.left.eval(f) && e.right.eval(f) }
+
+type pclOr struct{ left, right pclExpr }
+
+func (e *pclOr) eval(f map[string]interface{}) bool { return e.left.eval(f) || e.right.eval(f) }
+
+type pclNot struct{ expr pclExpr }
+
+func (e *pclNot) eval(f map[string]interface{}) bool { return !e.expr.eval(f) }
+
+type pclComparison struct {
+	path   string
+	op     string
+	value  string
+	number float64
+	re     *regexp.Regexp
+}
+
+func (c *pclComparison) eval(fields map[string]interface{}) bool {
+	v, ok := lookupEventField(fields, c.path)
+	if c.op == "exists" {
+		return ok
+	}
+	if !ok {
+		return false
+	}
+
+	s := eventFieldString(v)
+	switch c.op {
+	case "matches":
+		return s == c.value
+	case "matches part":
+		return strings.Contains(strings.ToLower(s), strings.ToLower(c.value))
+	case "matches regex":
+		return c.re.MatchString(s)
+	}
+
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return false
+	}
+	switch c.op {
+	case "<":
+		return n < c.number
+	case "<=":
+		return n <= c.number
+	case ">":
+		return n > c.number
+	case ">=":
+		return n >= c.number
+	case "==":
+		return n == c.number
+	case "!=":
+		return n != c.number
+	}
+	return false
+}
+
+// parsePCL parses a PCL expression. Regular expressions are compiled with
+// Go's RE2 engine, the same syntax the PagerDuty API accepts.
+func parsePCL(expression string) (pclExpr, error) {
+	tokens, err := tokenizePCL(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pclParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q in PCL expression %q", p.peek().text, expression)
+	}
+	return expr, nil
+}
+
+type pclTokenKind int
+
+const (
+	pclTokenWord pclTokenKind = iota
+	pclTokenString
+	pclTokenSymbol
+)
+
+type pclToken struct {
+	kind pclTokenKind
+	text string
+}
+
+func tokenizePCL(s string) ([]pclToken, error) {
+	var tokens []pclToken
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, pclToken{pclTokenSymbol, string(c)})
+			i++
+		case strings.ContainsRune("<>=!", c):
+			j := i + 1
+			if j < len(s) && s[j] == '=' {
+				j++
+			}
+			tokens = append(tokens, pclToken{pclTokenSymbol, s[i:j]})
+			i = j
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(s) && rune(s[j]) != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) && (rune(s[j+1]) == c || s[j+1] == '\\') {
+					j++
+				}
+				b.WriteByte(s[j])
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string in PCL expression %q", s)
+			}
+			tokens = append(tokens, pclToken{pclTokenString, b.String()})
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !unicode.IsSpace(rune(s[j])) && !strings.ContainsRune("()<>=!'\"", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, pclToken{pclTokenWord, s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type pclParser struct {
+	tokens []pclToken
+	pos    int
+}
+
+func (p *pclParser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *pclParser) peek() pclToken {
+	if p.done() {
+		return pclToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *pclParser) acceptWord(word string) bool {
+	if t := p.peek(); !p.done() && t.kind == pclTokenWord && strings.EqualFold(t.text, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *pclParser) parseOr() (pclExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptWord("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &pclOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *pclParser) parseAnd() (pclExpr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptWord("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &pclAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *pclParser) parseNot() (pclExpr, error) {
+	if p.acceptWord("not") {
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &pclNot{expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *pclParser) parsePrimary() (pclExpr, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of PCL expression")
+	}
+
+	t := p.peek()
+	if t.kind == pclTokenSymbol && t.text == "(" {
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.peek(); p.done() || t.text != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis in PCL expression")
+		}
+		p.pos++
+		return expr, nil
+	}
+	if t.kind != pclTokenWord {
+		return nil, fmt.Errorf("expected a field path, got %q", t.text)
+	}
+	if strings.EqualFold(t.text, "now") {
+		return nil, fmt.Errorf("time based conditions (`now in ...`) are not supported")
+	}
+	p.pos++
+
+	c := &pclComparison{path: t.text}
+	negate := false
+	switch {
+	case p.acceptWord("exists"):
+		c.op = "exists"
+		return c, nil
+	case p.acceptWord("does"):
+		if !p.acceptWord("not") || !p.acceptWord("exist") {
+			return nil, fmt.Errorf("expected `does not exist` after %q", c.path)
+		}
+		return &pclNot{&pclComparison{path: c.path, op: "exists"}}, nil
+	case p.acceptWord("not"):
+		negate = true
+		fallthrough
+	case p.peek().kind == pclTokenWord && strings.EqualFold(p.peek().text, "matches"):
+		if !p.acceptWord("matches") {
+			return nil, fmt.Errorf("expected `matches` after %q", c.path)
+		}
+		c.op = "matches"
+		if p.acceptWord("part") {
+			c.op = "matches part"
+		} else if p.acceptWord("regex") {
+			c.op = "matches regex"
+		}
+	case p.peek().kind == pclTokenSymbol && pclNumericOperators[p.peek().text]:
+		c.op = p.peek().text
+		p.pos++
+	default:
+		return nil, fmt.Errorf("expected an operator after %q", c.path)
+	}
+
+	v := p.peek()
+	if p.done() || v.kind == pclTokenSymbol {
+		return nil, fmt.Errorf("expected a value after %q %s", c.path, c.op)
+	}
+	p.pos++
+	c.value = v.text
+
+	switch c.op {
+	case "matches", "matches part":
+		if v.kind != pclTokenString {
+			return nil, fmt.Errorf("expected a quoted string after %q %s", c.path, c.op)
+		}
+	case "matches regex":
+		re, err := regexp.Compile(c.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex for %q: %w", c.path, err)
+		}
+		c.re = re
+	default:
+		n, err := strconv.ParseFloat(c.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number after %q %s, got %q", c.path, c.op, c.value)
+		}
+		c.number = n
+	}
+
+	if negate {
+		return &pclNot{c}, nil
+	}
+	return c, nil
+}
+
+var pclNumericOperators = map[string]bool{"<": true, "<=": true, ">": true, ">=": true, "==": true, "!=": true}
+
+var eventFieldIndexRegexp = regexp.MustCompile(`^(.*)\[(\d+)\]$`)
+
+// lookupEventField resolves a dotted path, such as
+// `event.custom_details.hosts[0]`, in a tree of decoded JSON values.
+func lookupEventField(fields map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = fields
+	for _, part := range strings.Split(path, ".") {
+		var indexes []int
+		for {
+			m := eventFieldIndexRegexp.FindStringSubmatch(part)
+			if m == nil {
+				break
+			}
+			i, _ := strconv.Atoi(m[2])
+			indexes = append([]int{i}, indexes...)
+			part = m[1]
+		}
+
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = obj[part]
+		if !ok || current == nil {
+			return nil, false
+		}
+
+		for _, i := range indexes {
+			list, ok := current.([]interface{})
+			if !ok || i >= len(list) {
+				return nil, false
+			}
+			current = list[i]
+		}
+	}
+	return current, current != nil
+}
+
+// setEventField sets the value at a dotted path, creating the intermediate
+// objects as needed.
+func setEventField(fields map[string]interface{}, path string, value interface{}) {
+	parts := strings.Split(path, ".")
+	current := fields
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[part] = next
+		}
+		current = next
+	}
+	current[parts[len(parts)-1]] = value
+}
+
+func eventFieldString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,324 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// eventOrchestrationSimulationInput holds the event and the orchestration
+// paths an event is evaluated against. A nil path behaves as an empty one.
+type eventOrchestrationSimulationInput struct {
+	// Event as sent to the Events API v2
+	Event          map[string]interface{}
+	CacheVariables map[string]interface{}
+	Global         *pagerduty.EventOrchestrationPath
+	Router         *pagerduty.EventOrchestrationPath
+	Unrouted       *pagerduty.EventOrchestrationPath
+	// Service returns the path of a service the event is routed to
+	Service func(serviceID string) (*pagerduty.EventOrchestrationPath, error)
+}
+
+type eventOrchestrationSimulationMatch struct {
+	PathType string
+	Parent   string
+	Set      string
+	Rule     string
+}
+
+// eventOrchestrationSimulationResult is the outcome of evaluating an event.
+type eventOrchestrationSimulationResult struct {
+	Matches          []eventOrchestrationSimulationMatch
+	RouteTo          string
+	Dropped          bool
+	Severity         string
+	Priority         string
+	EventAction      string
+	EscalationPolicy string
+	Annotations      []string
+	Suppress         bool
+	Suspend          int
+	Variables        map[string]string
+	Extractions      map[string]string
+	Warnings         []string
+}
+
+type eventOrchestrationSimulation struct {
+	// fields are the namespaces PCL paths are resolved against, i.e.
+	// `event`, `raw_event`, `variables` and `cache_variables`.
+	fields map[string]interface{}
+	result *eventOrchestrationSimulationResult
+}
+
+// simulateEventOrchestration evaluates an event locally the way PagerDuty
+// does: the global path, then the router, then either the path of the
+// service the event is routed to or the unrouted path. In each path the
+// first enabled rule of a set whose conditions match is applied, a rule
+// routing to another set continues there and no matching rule applies the
+// catch all.
+func simulateEventOrchestration(in eventOrchestrationSimulationInput) (*eventOrchestrationSimulationResult, error) {
+	s := &eventOrchestrationSimulation{
+		fields: map[string]interface{}{
+			"event":           normalizeSimulationEvent(in.Event),
+			"raw_event":       in.Event,
+			"cache_variables": in.CacheVariables,
+		},
+		result: &eventOrchestrationSimulationResult{
+			Variables:   map[string]string{},
+			Extractions: map[string]string{},
+		},
+	}
+
+	if _, err := s.runPath(pagerduty.PathTypeGlobal, "", in.Global); err != nil || s.result.Dropped {
+		return s.result, err
+	}
+
+	routeTo, err := s.runPath(pagerduty.PathTypeRouter, "", in.Router)
+	if err != nil {
+		return s.result, err
+	}
+	if routeTo == "" {
+		routeTo = "unrouted"
+	}
+	s.result.RouteTo = routeTo
+
+	if routeTo == "unrouted" {
+		_, err = s.runPath(pagerduty.PathTypeUnrouted, "", in.Unrouted)
+		return s.result, err
+	}
+
+	var path *pagerduty.EventOrchestrationPath
+	if in.Service != nil {
+		if path, err = in.Service(routeTo); err != nil {
+			return s.result, err
+		}
+	}
+	_, err = s.runPath(pagerduty.PathTypeService, routeTo, path)
+	return s.result, err
+}
+
+// normalizeSimulationEvent maps an Events API v2 body to the fields PCL
+// exposes under `event`. Bodies without a `payload` are used as is.
+func normalizeSimulationEvent(raw map[string]interface{}) map[string]interface{} {
+	event := map[string]interface{}{}
+	payload, ok := raw["payload"].(map[string]interface{})
+	if !ok {
+		for k, v := range raw {
+			event[k] = v
+		}
+		return event
+	}
+
+	for k, v := range payload {
+		event[k] = v
+	}
+	for _, k := range []string{"event_action", "dedup_key", "client", "client_url", "images", "links"} {
+		if v, ok := raw[k]; ok {
+			event[k] = v
+		}
+	}
+	return event
+}
+
+func (s *eventOrchestrationSimulation) runPath(pathType, parent string, path *pagerduty.EventOrchestrationPath) (string, error) {
+	if path == nil {
+		path = emptyOrchestrationPathStructBuilder(pathType)
+	}
+	// Variables are only visible to the rules of the path defining them.
+	s.fields["variables"] = map[string]interface{}{}
+
+	visited := map[string]bool{}
+	setID := "start"
+	for {
+		if visited[setID] {
+			return "", fmt.Errorf("%s path routes to set %q more than once", pathType, setID)
+		}
+		visited[setID] = true
+
+		set := findEventOrchestrationPathSet(path, setID)
+		if set == nil {
+			return "", fmt.Errorf("%s path routes to set %q which doesn't exist", pathType, setID)
+		}
+
+		rule, err := s.firstMatchingRule(set)
+		if err != nil {
+			return "", fmt.Errorf("%s path, set %q: %w", pathType, setID, err)
+		}
+
+		if rule == nil {
+			s.result.Matches = append(s.result.Matches, eventOrchestrationSimulationMatch{PathType: pathType, Parent: parent, Set: setID, Rule: "catch_all"})
+			var actions *pagerduty.EventOrchestrationPathRuleActions
+			if path.CatchAll != nil {
+				actions = path.CatchAll.Actions
+			}
+			s.apply(actions)
+			if actions == nil {
+				return "", nil
+			}
+			return actions.RouteTo, nil
+		}
+
+		s.result.Matches = append(s.result.Matches, eventOrchestrationSimulationMatch{PathType: pathType, Parent: parent, Set: setID, Rule: rule.ID})
+		s.apply(rule.Actions)
+		if rule.Actions == nil || s.result.Dropped {
+			return "", nil
+		}
+
+		if pathType == pagerduty.PathTypeRouter {
+			return s.routerDestination(rule.Actions), nil
+		}
+		if rule.Actions.RouteTo == "" {
+			return "", nil
+		}
+		setID = rule.Actions.RouteTo
+	}
+}
+
+func findEventOrchestrationPathSet(path *pagerduty.EventOrchestrationPath, id string) *pagerduty.EventOrchestrationPathSet {
+	for _, set := range path.Sets {
+		if set != nil && set.ID == id {
+			return set
+		}
+	}
+	return nil
+}
+
+func (s *eventOrchestrationSimulation) firstMatchingRule(set *pagerduty.EventOrchestrationPathSet) (*pagerduty.EventOrchestrationPathRule, error) {
+	for _, rule := range set.Rules {
+		if rule == nil || rule.Disabled {
+			continue
+		}
+		// A rule without conditions always matches, otherwise any of its
+		// conditions has to be true.
+		matched := len(rule.Conditions) == 0
+		for _, condition := range rule.Conditions {
+			expr, err := parsePCL(condition.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: %w", rule.ID, err)
+			}
+			if expr.eval(s.fields) {
+				matched = true
+				break
+			}
+		}
+		if matched {
+			return rule, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *eventOrchestrationSimulation) routerDestination(actions *pagerduty.EventOrchestrationPathRuleActions) string {
+	dynamic := actions.DynamicRouteTo
+	if dynamic == nil || dynamic.Source == "" {
+		return actions.RouteTo
+	}
+
+	value, ok := s.extract(dynamic.Source, dynamic.Regex)
+	if !ok {
+		return "unrouted"
+	}
+	if dynamic.LookupBy != "service_id" {
+		s.result.Warnings = append(s.result.Warnings, fmt.Sprintf("Dynamic route by %s to %q can't be resolved locally, the event is considered unrouted", dynamic.LookupBy, value))
+		return "unrouted"
+	}
+	return value
+}
+
+func (s *eventOrchestrationSimulation) apply(actions *pagerduty.EventOrchestrationPathRuleActions) {
+	if actions == nil {
+		return
+	}
+
+	for _, v := range actions.Variables {
+		if v.Type != "regex" {
+			continue
+		}
+		if value, ok := s.extract(v.Path, v.Value); ok {
+			s.fields["variables"].(map[string]interface{})[v.Name] = value
+			s.result.Variables[v.Name] = value
+		}
+	}
+
+	for _, e := range actions.Extractions {
+		var value string
+		if e.Template != "" {
+			value = s.renderTemplate(e.Template)
+		} else {
+			v, ok := s.extract(e.Source, e.Regex)
+			if !ok {
+				continue
+			}
+			value = v
+		}
+		setEventField(s.fields, e.Target, value)
+		s.result.Extractions[e.Target] = value
+	}
+
+	if actions.DropEvent {
+		s.result.Dropped = true
+	}
+	// Severity and event action rewrite the event seen by the next paths.
+	if actions.Severity != "" {
+		s.result.Severity = actions.Severity
+		setEventField(s.fields, "event.severity", actions.Severity)
+	}
+	if actions.Priority != "" {
+		s.result.Priority = actions.Priority
+	}
+	if actions.EventAction != "" {
+		s.result.EventAction = actions.EventAction
+		setEventField(s.fields, "event.event_action", actions.EventAction)
+	}
+	if actions.EscalationPolicy != nil && *actions.EscalationPolicy != "" {
+		s.result.EscalationPolicy = *actions.EscalationPolicy
+	}
+	if actions.Annotate != "" {
+		s.result.Annotations = append(s.result.Annotations, actions.Annotate)
+	}
+	if actions.Suppress {
+		s.result.Suppress = true
+	}
+	if actions.Suspend != nil {
+		s.result.Suspend = *actions.Suspend
+	}
+}
+
+// extract applies `expr` to the value of the field at `path`, returning the
+// first capture group, or the whole match when it has no groups.
+func (s *eventOrchestrationSimulation) extract(path, expr string) (string, bool) {
+	v, ok := lookupEventField(s.fields, path)
+	if !ok {
+		return "", false
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		s.result.Warnings = append(s.result.Warnings, fmt.Sprintf("Invalid regex %q: %s", expr, err))
+		return "", false
+	}
+
+	m := re.FindStringSubmatch(eventFieldString(v))
+	switch {
+	case m == nil:
+		return "", false
+	case len(m) > 1:
+		return m[1], true
+	}
+	return m[0], true
+}
+
+var simulationTemplateRegexp = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+// renderTemplate replaces each `{{path}}` in a template with the value of
+// the field at `path`, or an empty string when it doesn't exist.
+func (s *eventOrchestrationSimulation) renderTemplate(template string) string {
+	return simulationTemplateRegexp.ReplaceAllStringFunc(template, func(m string) string {
+		path := strings.TrimSpace(simulationTemplateRegexp.FindStringSubmatch(m)[1])
+		if v, ok := lookupEventField(s.fields, path); ok {
+			return eventFieldString(v)
+		}
+		return ""
+	})
+}
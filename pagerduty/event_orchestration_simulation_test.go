@@ -0,0 +1,199 @@
+package pagerduty
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestParsePCL(t *testing.T) {
+	fields := map[string]interface{}{
+		"event": map[string]interface{}{
+			"summary":  "Disk full on db-01",
+			"severity": "critical",
+			"custom_details": map[string]interface{}{
+				"hosts": []interface{}{"db-01", "db-02"},
+				"usage": 97.0,
+			},
+		},
+	}
+
+	cases := []struct {
+		expression string
+		want       bool
+	}{
+		{`event.severity matches 'critical'`, true},
+		{`event.severity matches 'Critical'`, false},
+		{`event.summary matches part 'DISK FULL'`, true},
+		{`event.summary matches regex 'db-\d+$'`, true},
+		{`event.custom_details.hosts[1] matches "db-02"`, true},
+		{`event.custom_details.usage > 95`, true},
+		{`event.custom_details.usage <= 95`, false},
+		{`event.custom_details.missing exists`, false},
+		{`event.custom_details.missing does not exist`, true},
+		{`event.summary not matches part 'cpu'`, true},
+		{`not (event.severity matches 'info' or event.severity matches 'warning')`, true},
+		{`event.severity matches 'critical' and event.summary matches part 'cpu'`, false},
+		{`event.severity matches 'info' or event.summary matches part 'disk' and event.custom_details.usage > 90`, true},
+	}
+
+	for _, c := range cases {
+		expr, err := parsePCL(c.expression)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", c.expression, err)
+			continue
+		}
+		if got := expr.eval(fields); got != c.want {
+			t.Errorf("%s: want %t; got %t", c.expression, c.want, got)
+		}
+	}
+
+	for _, expression := range []string{
+		`event.summary matches`,
+		`event.summary matches regex '('`,
+		`(event.summary exists`,
+		`event.summary matches part 'x' and`,
+		`now in Mon,Tue 08:00:00 to 18:00:00 America/New_York`,
+	} {
+		if _, err := parsePCL(expression); err == nil {
+			t.Errorf("%s: expected an error", expression)
+		}
+	}
+}
+
+func TestSimulateEventOrchestration(t *testing.T) {
+	suspend := 300
+	global := &pagerduty.EventOrchestrationPath{
+		Sets: []*pagerduty.EventOrchestrationPathSet{
+			{
+				ID: "start",
+				Rules: []*pagerduty.EventOrchestrationPathRule{
+					{
+						ID:         "disabled",
+						Disabled:   true,
+						Conditions: []*pagerduty.EventOrchestrationPathRuleCondition{},
+						Actions:    &pagerduty.EventOrchestrationPathRuleActions{DropEvent: true},
+					},
+					{
+						ID: "db",
+						Conditions: []*pagerduty.EventOrchestrationPathRuleCondition{
+							{Expression: "event.summary matches part 'db-'"},
+						},
+						Actions: &pagerduty.EventOrchestrationPathRuleActions{
+							RouteTo: "db",
+							Variables: []*pagerduty.EventOrchestrationPathActionVariables{
+								{Name: "host", Path: "event.summary", Type: "regex", Value: `(db-\d+)`},
+							},
+							Extractions: []*pagerduty.EventOrchestrationPathActionExtractions{
+								{Target: "event.custom_details.host", Template: "{{variables.host}}"},
+							},
+						},
+					},
+				},
+			},
+			{
+				ID: "db",
+				Rules: []*pagerduty.EventOrchestrationPathRule{
+					{
+						ID: "db-01",
+						Conditions: []*pagerduty.EventOrchestrationPathRuleCondition{
+							{Expression: "event.custom_details.host matches 'db-01'"},
+						},
+						Actions: &pagerduty.EventOrchestrationPathRuleActions{
+							Severity: "critical",
+							Suspend:  &suspend,
+						},
+					},
+				},
+			},
+		},
+	}
+	router := &pagerduty.EventOrchestrationPath{
+		Sets: []*pagerduty.EventOrchestrationPathSet{
+			{
+				ID: "start",
+				Rules: []*pagerduty.EventOrchestrationPathRule{
+					{
+						ID: "to-db",
+						Conditions: []*pagerduty.EventOrchestrationPathRuleCondition{
+							{Expression: "event.severity matches 'critical'"},
+						},
+						Actions: &pagerduty.EventOrchestrationPathRuleActions{RouteTo: "PDB"},
+					},
+				},
+			},
+		},
+		CatchAll: &pagerduty.EventOrchestrationPathCatchAll{
+			Actions: &pagerduty.EventOrchestrationPathRuleActions{RouteTo: "unrouted"},
+		},
+	}
+	service := &pagerduty.EventOrchestrationPath{
+		Sets: []*pagerduty.EventOrchestrationPathSet{
+			{ID: "start"},
+		},
+		CatchAll: &pagerduty.EventOrchestrationPathCatchAll{
+			Actions: &pagerduty.EventOrchestrationPathRuleActions{Priority: "P1", Suppress: true},
+		},
+	}
+
+	event := map[string]interface{}{
+		"event_action": "trigger",
+		"payload": map[string]interface{}{
+			"summary":  "Disk full on db-01",
+			"severity": "warning",
+			"source":   "db-01",
+		},
+	}
+
+	result, err := simulateEventOrchestration(eventOrchestrationSimulationInput{
+		Event:  event,
+		Global: global,
+		Router: router,
+		Service: func(serviceID string) (*pagerduty.EventOrchestrationPath, error) {
+			if serviceID != "PDB" {
+				t.Fatalf("unexpected service %s", serviceID)
+			}
+			return service, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantMatches := []eventOrchestrationSimulationMatch{
+		{PathType: "global", Set: "start", Rule: "db"},
+		{PathType: "global", Set: "db", Rule: "db-01"},
+		{PathType: "router", Set: "start", Rule: "to-db"},
+		{PathType: "service", Parent: "PDB", Set: "start", Rule: "catch_all"},
+	}
+	if !reflect.DeepEqual(result.Matches, wantMatches) {
+		t.Errorf("want matches %v; got %v", wantMatches, result.Matches)
+	}
+	if result.RouteTo != "PDB" {
+		t.Errorf("want route_to PDB; got %s", result.RouteTo)
+	}
+	if result.Severity != "critical" || result.Priority != "P1" || !result.Suppress || result.Suspend != 300 {
+		t.Errorf("unexpected actions %+v", result)
+	}
+	if result.Variables["host"] != "db-01" || result.Extractions["event.custom_details.host"] != "db-01" {
+		t.Errorf("unexpected variables %v and extractions %v", result.Variables, result.Extractions)
+	}
+
+	// An event not matching the router ends in the unrouted path.
+	event["payload"].(map[string]interface{})["summary"] = "CPU high"
+	result, err = simulateEventOrchestration(eventOrchestrationSimulationInput{Event: event, Global: global, Router: router})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RouteTo != "unrouted" || result.Matches[len(result.Matches)-1].PathType != "unrouted" {
+		t.Errorf("want the event unrouted; got %+v", result)
+	}
+
+	// Routing to a missing set is reported.
+	global.Sets[0].Rules[1].Actions.RouteTo = "missing"
+	event["payload"].(map[string]interface{})["summary"] = "Disk full on db-01"
+	if _, err := simulateEventOrchestration(eventOrchestrationSimulationInput{Event: event, Global: global}); err == nil {
+		t.Error("expected an error routing to a missing set")
+	}
+}
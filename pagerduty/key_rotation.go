@@ -0,0 +1,165 @@
+package pagerduty
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// keyRotation describes how a resource rotates the key of an integration
+// with a `key_rotation` block: a rotated integration is created alongside the
+// current one, so that both keys are accepted while senders are updated, and
+// takes the place of the current integration once cut over.
+type keyRotation struct {
+	// rotatedKey is the computed attribute holding the key of the rotated
+	// integration.
+	rotatedKey string
+	// cutOverComputed are the attributes only known once the rotated
+	// integration takes the place of the current one.
+	cutOverComputed []string
+}
+
+type keyRotationStep int
+
+const (
+	keyRotationNone keyRotationStep = iota
+	keyRotationStart
+	keyRotationCutOver
+	keyRotationAbandon
+)
+
+func keyRotationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"cut_over": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"ttl": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateKeyRotationTTL,
+				},
+			},
+		},
+	}
+}
+
+// keyRotationDue tells whether a pending key rotation must be cut over,
+// either explicitly or because its TTL elapsed.
+func keyRotationDue(cutOver bool, ttl, startedAt string, now time.Time) bool {
+	if cutOver {
+		return true
+	}
+	if ttl == "" || startedAt == "" {
+		return false
+	}
+	d, err := time.ParseDuration(ttl)
+	if err != nil {
+		return false
+	}
+	started, err := time.Parse(time.RFC3339, startedAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(started.Add(d))
+}
+
+func validateKeyRotationTTL(v interface{}, k string) (ws []string, es []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		es = append(es, fmt.Errorf("%s must be a duration like 72h, got: %s", k, v))
+		return
+	}
+	if d <= 0 {
+		es = append(es, fmt.Errorf("%s must be positive, got: %s", k, v))
+	}
+	return
+}
+
+// customizeDiff plans the effects of the `key_rotation` block. Starting a
+// rotation creates a rotated integration alongside the current one, and
+// cutting it over deletes the current integration, so that the rotated one
+// takes its place and its key.
+func (k keyRotation) customizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	pending := diff.Get("rotated_integration_id").(string) != ""
+	oldRotation, newRotation := diff.GetChange("key_rotation.0.id")
+	switch {
+	case newRotation.(string) == "":
+		if pending {
+			// Removing key_rotation abandons the pending rotation.
+			return k.clearDiff(diff)
+		}
+	case oldRotation.(string) != newRotation.(string):
+		if pending {
+			return fmt.Errorf("Invalid configuration in key_rotation.0.id: rotation %q hasn't been cut over yet, cut it over or remove key_rotation to abandon it", oldRotation)
+		}
+		for _, attr := range []string{"rotated_integration_id", k.rotatedKey, "rotation_started_at"} {
+			if err := diff.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+	case pending && keyRotationDue(diff.Get("key_rotation.0.cut_over").(bool), diff.Get("key_rotation.0.ttl").(string), diff.Get("rotation_started_at").(string), time.Now()):
+		for _, attr := range k.cutOverComputed {
+			if err := diff.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+		return k.clearDiff(diff)
+	}
+	return nil
+}
+
+func (k keyRotation) clearDiff(diff *schema.ResourceDiff) error {
+	for _, attr := range []string{"rotated_integration_id", k.rotatedKey, "rotation_started_at"} {
+		if err := diff.SetNew(attr, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// plannedStep tells which step of the key rotation the plan applies, along
+// with the ID of the rotated integration before the plan.
+func (k keyRotation) plannedStep(d *schema.ResourceData) (keyRotationStep, string) {
+	oldRotatedID, rotatedID := d.GetChange("rotated_integration_id")
+	oldRotation, newRotation := d.GetChange("key_rotation.0.id")
+
+	switch {
+	case oldRotatedID.(string) != "" && rotatedID.(string) == "" && newRotation.(string) == "":
+		return keyRotationAbandon, oldRotatedID.(string)
+	case oldRotatedID.(string) != "" && rotatedID.(string) == "":
+		return keyRotationCutOver, oldRotatedID.(string)
+	case oldRotation.(string) != newRotation.(string) && newRotation.(string) != "":
+		return keyRotationStart, oldRotatedID.(string)
+	}
+	return keyRotationNone, oldRotatedID.(string)
+}
+
+// setState records the pending rotated integration, or its absence when id
+// is empty.
+func (k keyRotation) setState(d *schema.ResourceData, id, key, startedAt string) error {
+	if id == "" {
+		key, startedAt = "", ""
+	}
+	if err := d.Set("rotated_integration_id", id); err != nil {
+		return err
+	}
+	if err := d.Set(k.rotatedKey, key); err != nil {
+		return err
+	}
+	return d.Set("rotation_started_at", startedAt)
+}
@@ -0,0 +1,119 @@
+package pagerduty
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestKeyRotationDue(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	startedAt := now.Add(-48 * time.Hour).Format(time.RFC3339)
+
+	cases := map[string]struct {
+		cutOver   bool
+		ttl       string
+		startedAt string
+		due       bool
+	}{
+		"cut over":             {cutOver: true, startedAt: startedAt, due: true},
+		"without cut over":     {startedAt: startedAt},
+		"ttl elapsed":          {ttl: "24h", startedAt: startedAt, due: true},
+		"ttl just elapsed":     {ttl: "48h", startedAt: startedAt, due: true},
+		"ttl not elapsed":      {ttl: "72h", startedAt: startedAt},
+		"rotation not started": {ttl: "24h"},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if due := keyRotationDue(c.cutOver, c.ttl, c.startedAt, now); due != c.due {
+				t.Errorf("expected due to be %v, got %v", c.due, due)
+			}
+		})
+	}
+}
+
+func TestEventOrchestrationIntegrationKeyRotationDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1b49abe7",
+		Attributes: map[string]string{
+			"id":                       "1b49abe7",
+			"event_orchestration":      "19acac92",
+			"label":                    "Example integration",
+			"parameters.#":             "1",
+			"parameters.0.routing_key": "R0UTING",
+			"parameters.0.type":        "global",
+			"key_rotation.#":           "1",
+			"key_rotation.0.id":        "2026-10",
+			"key_rotation.0.cut_over":  "false",
+			"key_rotation.0.ttl":       "",
+			"rotated_integration_id":   "5c28ad04",
+			"rotated_routing_key":      "R0TATED",
+			"rotation_started_at":      "2026-10-19T12:00:00Z",
+		},
+	}
+	config := func(keyRotation map[string]interface{}) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"event_orchestration": "19acac92",
+			"label":               "Example integration",
+		}
+		if keyRotation != nil {
+			raw["key_rotation"] = []interface{}{keyRotation}
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+
+	r := resourcePagerDutyEventOrchestrationIntegration()
+
+	t.Run("pending", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), state, config(map[string]interface{}{"id": "2026-10"}), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != nil && len(diff.Attributes) > 0 {
+			t.Errorf("expected no diff, got %v", diff.Attributes)
+		}
+	})
+
+	t.Run("cut over", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), state, config(map[string]interface{}{"id": "2026-10", "cut_over": true}), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a := diff.Attributes["parameters.#"]; a == nil || !a.NewComputed {
+			t.Errorf("expected parameters to be known after apply, got %v", a)
+		}
+		if a := diff.Attributes["rotated_integration_id"]; a == nil || a.New != "" {
+			t.Errorf("expected rotated_integration_id to be cleared, got %v", a)
+		}
+	})
+
+	t.Run("abandon", func(t *testing.T) {
+		diff, err := r.Diff(context.Background(), state, config(nil), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a := diff.Attributes["rotated_integration_id"]; a == nil || a.New != "" {
+			t.Errorf("expected rotated_integration_id to be cleared, got %v", a)
+		}
+	})
+
+	t.Run("rotate again before cutting over", func(t *testing.T) {
+		_, err := r.Diff(context.Background(), state, config(map[string]interface{}{"id": "2027-04"}), nil)
+		if err == nil || !strings.Contains(err.Error(), "hasn't been cut over yet") {
+			t.Errorf("expected a pending rotation error, got %v", err)
+		}
+	})
+
+	t.Run("migrate before cutting over", func(t *testing.T) {
+		c := config(map[string]interface{}{"id": "2026-10"})
+		c.Raw["event_orchestration"] = "8f1a3c55"
+		c.Config["event_orchestration"] = "8f1a3c55"
+		_, err := r.Diff(context.Background(), state, c, nil)
+		if err == nil || !strings.Contains(err.Error(), "pending routing key rotation") {
+			t.Errorf("expected a pending rotation error, got %v", err)
+		}
+	})
+}
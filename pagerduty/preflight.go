@@ -0,0 +1,44 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// preflightAbility is an ability of the account needed by a resource, only
+// when `attribute` is set or always when it's empty.
+type preflightAbility struct {
+	ability   string
+	attribute string
+}
+
+// customizePreflightDiff fails the plan of a resource about to be created or
+// updated when the account is known to lack any of `abilities` or the
+// provider to lack the OAuth `scope`, instead of waiting for the API to
+// reject the change.
+func customizePreflightDiff(resourceType, scope string, abilities ...preflightAbility) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() != "" && len(diff.GetChangedKeysPrefix("")) == 0 {
+			return nil
+		}
+
+		config := meta.(*Config)
+		// Abilities are loaded along the client.
+		if _, err := config.Client(); err != nil {
+			return nil
+		}
+
+		var required []string
+		for _, a := range abilities {
+			if a.attribute == "" {
+				required = append(required, a.ability)
+				continue
+			}
+			if _, ok := diff.GetOk(a.attribute); ok {
+				required = append(required, a.ability)
+			}
+		}
+		return config.Capabilities().Check(resourceType, scope, required...)
+	}
+}
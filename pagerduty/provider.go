@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -84,30 +85,54 @@ func Provider(isMux bool) *schema.Provider {
 				Optional: true,
 				Default:  false,
 			},
+
+			"default_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PAGERDUTY_READ_ONLY", false),
+			},
+
+			"default_deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"pagerduty_escalation_policy":                          dataSourcePagerDutyEscalationPolicy(),
-			"pagerduty_licenses":                                   dataSourcePagerDutyLicenses(),
-			"pagerduty_user_contact_method":                        dataSourcePagerDutyUserContactMethod(),
-			"pagerduty_team":                                       dataSourcePagerDutyTeam(),
-			"pagerduty_teams":                                      dataSourcePagerDutyTeams(),
-			"pagerduty_vendor":                                     dataSourcePagerDutyVendor(),
-			"pagerduty_service":                                    dataSourcePagerDutyService(),
-			"pagerduty_service_integration":                        dataSourcePagerDutyServiceIntegration(),
-			"pagerduty_automation_actions_action":                  dataSourcePagerDutyAutomationActionsAction(),
-			"pagerduty_automation_actions_runner":                  dataSourcePagerDutyAutomationActionsRunner(),
-			"pagerduty_business_service":                           dataSourcePagerDutyBusinessService(),
-			"pagerduty_event_orchestration":                        dataSourcePagerDutyEventOrchestration(),
-			"pagerduty_event_orchestration_global_cache_variable":  dataSourcePagerDutyEventOrchestrationGlobalCacheVariable(),
-			"pagerduty_event_orchestration_integration":            dataSourcePagerDutyEventOrchestrationIntegration(),
-			"pagerduty_event_orchestration_service_cache_variable": dataSourcePagerDutyEventOrchestrationServiceCacheVariable(),
-			"pagerduty_event_orchestrations":                       dataSourcePagerDutyEventOrchestrations(),
-			"pagerduty_incident_custom_field":                      dataSourcePagerDutyIncidentCustomField(),
-			"pagerduty_incident_workflow":                          dataSourcePagerDutyIncidentWorkflow(),
-			"pagerduty_priority":                                   dataSourcePagerDutyPriority(),
-			"pagerduty_ruleset":                                    dataSourcePagerDutyRuleset(),
-			"pagerduty_team_members":                               dataSourcePagerDutyTeamMembers(),
+			"pagerduty_email_parser_test":                           dataSourcePagerDutyEmailParserTest(),
+			"pagerduty_escalation_policy":                           dataSourcePagerDutyEscalationPolicy(),
+			"pagerduty_licenses":                                    dataSourcePagerDutyLicenses(),
+			"pagerduty_user_contact_method":                         dataSourcePagerDutyUserContactMethod(),
+			"pagerduty_team":                                        dataSourcePagerDutyTeam(),
+			"pagerduty_teams":                                       dataSourcePagerDutyTeams(),
+			"pagerduty_vendor":                                      dataSourcePagerDutyVendor(),
+			"pagerduty_service":                                     dataSourcePagerDutyService(),
+			"pagerduty_service_integration":                         dataSourcePagerDutyServiceIntegration(),
+			"pagerduty_automation_actions_action":                   dataSourcePagerDutyAutomationActionsAction(),
+			"pagerduty_automation_actions_runner":                   dataSourcePagerDutyAutomationActionsRunner(),
+			"pagerduty_business_service":                            dataSourcePagerDutyBusinessService(),
+			"pagerduty_event_orchestration":                         dataSourcePagerDutyEventOrchestration(),
+			"pagerduty_event_orchestration_global_cache_variable":   dataSourcePagerDutyEventOrchestrationGlobalCacheVariable(),
+			"pagerduty_event_orchestration_global_cache_variables":  dataSourcePagerDutyEventOrchestrationGlobalCacheVariables(),
+			"pagerduty_event_orchestration_integration":             dataSourcePagerDutyEventOrchestrationIntegration(),
+			"pagerduty_event_orchestration_path_export":             dataSourcePagerDutyEventOrchestrationPathExport(),
+			"pagerduty_event_orchestration_service_cache_variable":  dataSourcePagerDutyEventOrchestrationServiceCacheVariable(),
+			"pagerduty_event_orchestration_service_cache_variables": dataSourcePagerDutyEventOrchestrationServiceCacheVariables(),
+			"pagerduty_event_orchestration_simulation":              dataSourcePagerDutyEventOrchestrationSimulation(),
+			"pagerduty_event_orchestrations":                        dataSourcePagerDutyEventOrchestrations(),
+			"pagerduty_incident_custom_field":                       dataSourcePagerDutyIncidentCustomField(),
+			"pagerduty_incident_workflow":                           dataSourcePagerDutyIncidentWorkflow(),
+			"pagerduty_priority":                                    dataSourcePagerDutyPriority(),
+			"pagerduty_ruleset":                                     dataSourcePagerDutyRuleset(),
+			"pagerduty_team_members":                                dataSourcePagerDutyTeamMembers(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -161,6 +186,13 @@ func Provider(isMux bool) *schema.Provider {
 
 		delete(p.ResourcesMap, "pagerduty_addon")
 		delete(p.ResourcesMap, "pagerduty_business_service")
+		delete(p.ResourcesMap, "pagerduty_escalation_policy")
+		delete(p.ResourcesMap, "pagerduty_event_orchestration_global")
+		delete(p.ResourcesMap, "pagerduty_event_orchestration_router")
+		delete(p.ResourcesMap, "pagerduty_event_orchestration_service")
+		delete(p.ResourcesMap, "pagerduty_event_orchestration_unrouted")
+		delete(p.ResourcesMap, "pagerduty_schedule")
+		delete(p.ResourcesMap, "pagerduty_service")
 		delete(p.ResourcesMap, "pagerduty_team")
 		delete(p.ResourcesMap, "pagerduty_team_membership")
 		delete(p.ResourcesMap, "pagerduty_user_contact_method")
@@ -218,7 +250,7 @@ func isMalformedForbiddenError(err error) bool {
 }
 
 func genError(err error, d *schema.ResourceData) error {
-	return fmt.Errorf("Error reading: %s: %s", d.Id(), err)
+	return fmt.Errorf("Error reading: %s: %w", d.Id(), util.TranslateAPIError(err))
 }
 
 func handleNotFoundError(err error, d *schema.ResourceData) error {
@@ -254,6 +286,10 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 		ApiUrlOverride:      data.Get("api_url_override").(string),
 		ServiceRegion:       serviceRegion,
 		InsecureTls:         data.Get("insecure_tls").(bool),
+		DefaultTags:         expandStringList(data.Get("default_tags").(*schema.Set).List()),
+		ReadOnly:            data.Get("read_only").(bool),
+
+		DefaultDeletionProtection: data.Get("default_deletion_protection").(bool),
 	}
 
 	useAuthTokenType := pagerduty.AuthTokenTypeAPIToken
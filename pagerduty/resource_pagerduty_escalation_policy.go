@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +22,12 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDefaultTagsDiff,
+			customizeDeletionProtectionDiff,
+			customizePreflightDiff("pagerduty_escalation_policy", "escalation_policies.write",
+				preflightAbility{ability: "teams", attribute: "teams"}),
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -102,6 +109,23 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 					},
 				},
 			},
+			"ignore_default_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -137,7 +161,7 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 
 	log.Printf("[INFO] Creating PagerDuty escalation policy: %s", escalationPolicy.Name)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(5*time.Minute, func() *retry.RetryError {
 		escalationPolicy, _, err := client.EscalationPolicies.Create(escalationPolicy)
 		if err != nil {
 			if isErrCode(err, 429) {
@@ -157,11 +181,27 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 		}
 		return nil
 	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	if err := applyDefaultTags(client, "escalation_policies", d); err != nil {
+		return err
+	}
+	return readDefaultTags(client, "escalation_policies", d, meta)
 }
 
 func resourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading PagerDuty escalation policy: %s", d.Id())
-	return fetchEscalationPolicy(d, meta, handleNotFoundError)
+	if err := fetchEscalationPolicy(d, meta, handleNotFoundError); err != nil || d.Id() == "" {
+		return err
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+	return readDefaultTags(client, "escalation_policies", d, meta)
 }
 
 func fetchEscalationPolicy(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
@@ -232,6 +272,13 @@ func resourcePagerDutyEscalationPolicyUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if err := applyDefaultTags(client, "escalation_policies", d); err != nil {
+		return err
+	}
+	if !d.HasChangesExcept("tags_all", "ignore_default_tags", "deletion_protection") {
+		return readDefaultTags(client, "escalation_policies", d, meta)
+	}
+
 	escalationPolicy := buildEscalationPolicyStruct(d)
 
 	log.Printf("[INFO] Updating PagerDuty escalation policy: %s", d.Id())
@@ -282,6 +329,10 @@ func resourcePagerDutyEscalationPolicyDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_escalation_policy"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty escalation policy: %s", d.Id())
 
 	// Retrying to give other resources (such as services) to delete
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -19,6 +20,10 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDeletionProtectionDiff,
+			customizePreflightDiff("pagerduty_event_orchestration", "event_orchestrations.write"),
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -69,6 +74,11 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -164,6 +174,10 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	if !d.HasChangeExcept("deletion_protection") {
+		return nil
+	}
+
 	orchestration := buildEventOrchestrationStruct(d)
 
 	log.Printf("[INFO] Updating PagerDuty Event Orchestration: %s", d.Id())
@@ -192,6 +206,10 @@ func resourcePagerDutyEventOrchestrationDelete(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_event_orchestration"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty Event Orchestration: %s", d.Id())
 	if _, err := client.EventOrchestrations.Delete(d.Id()); err != nil {
 		return err
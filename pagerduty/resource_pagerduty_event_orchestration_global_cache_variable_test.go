@@ -47,6 +47,13 @@ func TestAccPagerDutyEventOrchestrationGlobalCacheVariable_Basic(t *testing.T) {
 			data_type = "boolean"
 		}
 	`
+	invalidConfigRegex := `
+		configuration {
+			type = "recent_value"
+			source = "event.summary"
+			regex = "(.*"
+		}
+	`
 	config1 := `
 		configuration {
 			type = "trigger_event_count"
@@ -99,6 +106,12 @@ func TestAccPagerDutyEventOrchestrationGlobalCacheVariable_Basic(t *testing.T) {
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile("Invalid configuration: data_type and ttl_seconds cannot be null when type is external_data"),
 			},
+			// cache variable with an invalid regex:
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(orch, name1, orchn1, disabled1, invalidConfigRegex, cond1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid configuration in configuration.0.regex: error parsing regexp"),
+			},
 			// configure a valid cache variable:
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(orch, name1, orchn1, disabled1, config1, cond1),
@@ -23,6 +23,7 @@ func resourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePagerDutyEventOrchestrationIntegrationImport,
 		},
+		CustomizeDiff: customizeEventOrchestrationIntegrationRotationDiff,
 		Schema: map[string]*schema.Schema{
 			"event_orchestration": {
 				Type:     schema.TypeString,
@@ -52,10 +53,37 @@ func resourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
 					},
 				},
 			},
+			"key_rotation": keyRotationSchema(),
+			"rotated_integration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotated_routing_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// eventOrchestrationIntegrationKeyRotation rotates the routing key of an
+// event orchestration integration.
+var eventOrchestrationIntegrationKeyRotation = keyRotation{
+	rotatedKey:      "rotated_routing_key",
+	cutOverComputed: []string{"parameters"},
+}
+
+func customizeEventOrchestrationIntegrationRotationDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("event_orchestration") && diff.Get("rotated_integration_id").(string) != "" {
+		return fmt.Errorf("Integration '%s' has a pending routing key rotation: cut it over or remove key_rotation to abandon it before migrating the integration", diff.Id())
+	}
+	return eventOrchestrationIntegrationKeyRotation.customizeDiff(diff)
+}
+
 func getEventOrchestrationIntegrationPayloadData(d *schema.ResourceData) (string, *pagerduty.EventOrchestrationIntegration) {
 	orchestrationId := d.Get("event_orchestration").(string)
 
@@ -117,7 +145,7 @@ func resourcePagerDutyEventOrchestrationIntegrationCreate(ctx context.Context, d
 
 	if retryErr != nil {
 		time.Sleep(2 * time.Second)
-		return diag.FromErr(retryErr)
+		return apiErrorDiagnostics(retryErr, resourcePagerDutyEventOrchestrationIntegration().Schema)
 	}
 
 	return nil
@@ -149,6 +177,12 @@ func resourcePagerDutyEventOrchestrationIntegrationRead(ctx context.Context, d *
 		return diag.FromErr(retryErr)
 	}
 
+	if d.Id() != "" {
+		if err := fetchRotatedEventOrchestrationIntegration(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -196,7 +230,7 @@ func resourcePagerDutyEventOrchestrationIntegrationUpdate(ctx context.Context, d
 
 		if retryErr != nil {
 			time.Sleep(2 * time.Second)
-			return diag.FromErr(retryErr)
+			return apiErrorDiagnostics(retryErr, resourcePagerDutyEventOrchestrationIntegration().Schema)
 		}
 	}
 
@@ -224,22 +258,142 @@ func resourcePagerDutyEventOrchestrationIntegrationUpdate(ctx context.Context, d
 
 		if retryErr != nil {
 			time.Sleep(2 * time.Second)
-			return diag.FromErr(retryErr)
+			return apiErrorDiagnostics(retryErr, resourcePagerDutyEventOrchestrationIntegration().Schema)
+		}
+
+		// Keep a pending rotated integration in sync with the current one.
+		if oldRotatedID, rotatedID := d.GetChange("rotated_integration_id"); rotatedID.(string) != "" && oldRotatedID == rotatedID {
+			log.Printf("[INFO] Updating rotated Integration '%s' for PagerDuty Event Orchestration: %s", rotatedID, oid)
+
+			if _, _, err := client.EventOrchestrationIntegrations.UpdateContext(ctx, oid, rotatedID.(string), payload); err != nil {
+				return apiErrorDiagnostics(err, resourcePagerDutyEventOrchestrationIntegration().Schema)
+			}
 		}
 	}
 
+	if err := rotateEventOrchestrationIntegrationKey(ctx, d, meta); err != nil {
+		return apiErrorDiagnostics(err, resourcePagerDutyEventOrchestrationIntegration().Schema)
+	}
+
 	return nil
 }
 
-func resourcePagerDutyEventOrchestrationIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// rotateEventOrchestrationIntegrationKey applies the planned routing key
+// rotation change, if any.
+func rotateEventOrchestrationIntegrationKey(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	id := d.Id()
+	oid, payload := getEventOrchestrationIntegrationPayloadData(d)
+	step, rotatedID := eventOrchestrationIntegrationKeyRotation.plannedStep(d)
+
+	switch step {
+	case keyRotationAbandon:
+		log.Printf("[INFO] Abandoning routing key rotation of Integration '%s' for PagerDuty Event Orchestration: %s", d.Id(), oid)
+
+		if err := deleteEventOrchestrationIntegration(ctx, meta, oid, rotatedID); err != nil {
+			return err
+		}
+		return eventOrchestrationIntegrationKeyRotation.setState(d, "", "", "")
+	case keyRotationCutOver:
+		log.Printf("[INFO] Cutting over routing key rotation of Integration '%s' for PagerDuty Event Orchestration '%s' to '%s'", d.Id(), oid, rotatedID)
+
+		if err := deleteEventOrchestrationIntegration(ctx, meta, oid, d.Id()); err != nil {
+			return err
+		}
+		if _, err := fetchPagerDutyEventOrchestrationIntegration(ctx, d, meta, oid, rotatedID, false); err != nil {
+			return err
+		}
+		return eventOrchestrationIntegrationKeyRotation.setState(d, "", "", "")
+	case keyRotationStart:
+		_, newRotation := d.GetChange("key_rotation.0.id")
+		log.Printf("[INFO] Starting routing key rotation %s of Integration '%s' for PagerDuty Event Orchestration: %s", newRotation, d.Id(), oid)
+
+		var created *pagerduty.EventOrchestrationIntegration
+		retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			integration, _, err := client.EventOrchestrationIntegrations.CreateContext(ctx, oid, payload)
+			if err != nil {
+				if isErrCode(err, http.StatusBadRequest) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			created = integration
+			return nil
+		})
+		if retryErr != nil {
+			return retryErr
+		}
+
+		routingKey := ""
+		if created.Parameters != nil {
+			routingKey = created.Parameters.RoutingKey
+		}
+		return eventOrchestrationIntegrationKeyRotation.setState(d, created.ID, routingKey, time.Now().UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// fetchRotatedEventOrchestrationIntegration refreshes the routing key of the
+// pending rotated integration, forgetting the rotation when the integration
+// was deleted.
+func fetchRotatedEventOrchestrationIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	rotatedID := d.Get("rotated_integration_id").(string)
+	if rotatedID == "" {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	oid := d.Get("event_orchestration").(string)
+	log.Printf("[INFO] Reading rotated Integration '%s' for PagerDuty Event Orchestration: %s", rotatedID, oid)
+
+	rotated, _, err := client.EventOrchestrationIntegrations.GetContext(ctx, oid, rotatedID)
+	if err != nil {
+		if !isErrCode(err, http.StatusNotFound) {
+			return err
+		}
+		log.Printf("[WARN] Rotated Integration '%s' for PagerDuty Event Orchestration '%s' not found, forgetting the routing key rotation", rotatedID, oid)
+		return eventOrchestrationIntegrationKeyRotation.setState(d, "", "", "")
+	}
+	if rotated != nil && rotated.Parameters != nil {
+		return d.Set("rotated_routing_key", rotated.Parameters.RoutingKey)
+	}
+	return nil
+}
+
+func resourcePagerDutyEventOrchestrationIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	oid, _ := getEventOrchestrationIntegrationPayloadData(d)
 
+	if rotatedID := d.Get("rotated_integration_id").(string); rotatedID != "" {
+		if err := deleteEventOrchestrationIntegration(ctx, meta, oid, rotatedID); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := eventOrchestrationIntegrationKeyRotation.setState(d, "", "", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := deleteEventOrchestrationIntegration(ctx, meta, oid, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func deleteEventOrchestrationIntegration(ctx context.Context, meta interface{}, oid, id string) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
 	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		log.Printf("[INFO] Deleting Integration '%s' for PagerDuty Event Orchestration: %s", id, oid)
 		if _, err := client.EventOrchestrationIntegrations.DeleteContext(ctx, oid, id); err != nil {
@@ -260,11 +414,9 @@ func resourcePagerDutyEventOrchestrationIntegrationDelete(ctx context.Context, d
 
 	if retryErr != nil {
 		time.Sleep(2 * time.Second)
-		return diag.FromErr(retryErr)
+		return retryErr
 	}
 
-	d.SetId("")
-
 	return nil
 }
 
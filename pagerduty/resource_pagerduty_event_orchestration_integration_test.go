@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -86,6 +87,97 @@ func testAccCheckPagerDutyEventOrchestrationIntegrationDestroy(s *terraform.Stat
 	return nil
 }
 
+func TestAccPagerDutyEventOrchestrationIntegration_KeyRotation(t *testing.T) {
+	onp := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	rn := "pagerduty_event_orchestration_integration.int_1"
+	lbl := fmt.Sprintf("tf-integration-%s", acctest.RandString(5))
+	var currentID, rotatedID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationIntegrationKeyRotationConfig(onp, lbl, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationIntegrationAttr(rn, "orch_1"),
+					resource.TestCheckResourceAttr(rn, "rotated_integration_id", ""),
+					func(s *terraform.State) error {
+						currentID = s.RootModule().Resources[rn].Primary.ID
+						return nil
+					},
+				),
+			},
+			// start a rotation, keeping both integrations:
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationIntegrationKeyRotationConfig(onp, lbl, `
+			key_rotation {
+				id = "2026-10"
+			}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationIntegrationAttr(rn, "orch_1"),
+					resource.TestCheckResourceAttrPtr(rn, "id", &currentID),
+					resource.TestCheckResourceAttrSet(rn, "rotated_routing_key"),
+					resource.TestCheckResourceAttrSet(rn, "rotation_started_at"),
+					func(s *terraform.State) error {
+						rotatedID = s.RootModule().Resources[rn].Primary.Attributes["rotated_integration_id"]
+						return nil
+					},
+					testAccCheckPagerDutyEventOrchestrationIntegrationExists("orch_1", &rotatedID),
+				),
+			},
+			// starting another rotation before the cut-over fails:
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationIntegrationKeyRotationConfig(onp, lbl, `
+			key_rotation {
+				id = "2027-04"
+			}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("hasn't been cut over yet"),
+			},
+			// cut over, the rotated integration takes the place of the current one:
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationIntegrationKeyRotationConfig(onp, lbl, `
+			key_rotation {
+				id       = "2026-10"
+				cut_over = true
+			}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationIntegrationAttr(rn, "orch_1"),
+					resource.TestCheckResourceAttrPtr(rn, "id", &rotatedID),
+					resource.TestCheckResourceAttr(rn, "rotated_integration_id", ""),
+					resource.TestCheckResourceAttr(rn, "rotated_routing_key", ""),
+					testAccCheckPagerDutyEventOrchestrationIntegrationExistsNotRemotely("orch_1", &currentID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationExists(orn string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		oid := s.RootModule().Resources[fmt.Sprintf("pagerduty_event_orchestration.%s", orn)].Primary.ID
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		_, _, err := client.EventOrchestrationIntegrations.GetContext(context.Background(), oid, *id)
+		return err
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationExistsNotRemotely(orn string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		oid := s.RootModule().Resources[fmt.Sprintf("pagerduty_event_orchestration.%s", orn)].Primary.ID
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		if _, _, err := client.EventOrchestrationIntegrations.GetContext(context.Background(), oid, *id); err == nil {
+			return fmt.Errorf("Event Orchestration Integration %s still exists", *id)
+		}
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyEventOrchestrationIntegrationExistsNot(rn string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[rn]
@@ -172,3 +264,17 @@ func testAccCheckPagerDutyEventOrchestrationIntegrationDeletedConfig(onp string)
 		}
 	`, onp, onp)
 }
+
+func testAccCheckPagerDutyEventOrchestrationIntegrationKeyRotationConfig(onp, lbl, keyRotation string) string {
+	return fmt.Sprintf(`
+		resource "pagerduty_event_orchestration" "orch_1" {
+			name = "%s-1"
+		}
+
+		resource "pagerduty_event_orchestration_integration" "int_1" {
+			label = "%s"
+			event_orchestration = pagerduty_event_orchestration.orch_1.id
+%s
+		}
+	`, onp, lbl, keyRotation)
+}
@@ -16,6 +16,8 @@ func resourcePagerDutyEventRule() *schema.Resource {
 		Read:   resourcePagerDutyEventRuleRead,
 		Update: resourcePagerDutyEventRuleUpdate,
 		Delete: resourcePagerDutyEventRuleDelete,
+		CustomizeDiff: customizePreflightDiff("pagerduty_event_rule", "event_rules.write",
+			preflightAbility{ability: "event_rules"}),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
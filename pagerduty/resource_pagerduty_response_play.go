@@ -18,6 +18,8 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 		Read:   resourcePagerDutyResponsePlayRead,
 		Update: resourcePagerDutyResponsePlayUpdate,
 		Delete: resourcePagerDutyResponsePlayDelete,
+		CustomizeDiff: customizePreflightDiff("pagerduty_response_play", "response_plays.write",
+			preflightAbility{ability: "response_plays"}),
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyResponsePlayImport,
 		},
@@ -17,6 +17,8 @@ func resourcePagerDutyRuleset() *schema.Resource {
 		Read:   resourcePagerDutyRulesetRead,
 		Update: resourcePagerDutyRulesetUpdate,
 		Delete: resourcePagerDutyRulesetDelete,
+		CustomizeDiff: customizePreflightDiff("pagerduty_ruleset", "event_rules.write",
+			preflightAbility{ability: "event_rules"}),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,28 +24,33 @@ func resourcePagerDutySchedule() *schema.Resource {
 		Read:               resourcePagerDutyScheduleRead,
 		Update:             resourcePagerDutyScheduleUpdate,
 		Delete:             resourcePagerDutyScheduleDelete,
-		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			ln := diff.Get("layer.#").(int)
-			for li := 0; li <= ln; li++ {
-				rn := diff.Get(fmt.Sprintf("layer.%d.restriction.#", li)).(int)
-				for ri := 0; ri <= rn; ri++ {
-					t := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.type", li, ri)).(string)
-					isStartDayOfWeekSetWhenDailyRestrictionType := t == "daily_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) != 0
-					if isStartDayOfWeekSetWhenDailyRestrictionType {
-						return fmt.Errorf("start_day_of_week must only be set for a weekly_restriction schedule restriction type")
-					}
-					isStartDayOfWeekNotSetWhenWeeklyRestrictionType := t == "weekly_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) == 0
-					if isStartDayOfWeekNotSetWhenWeeklyRestrictionType {
-						return fmt.Errorf("start_day_of_week must be set for a weekly_restriction schedule restriction type")
-					}
-					ds := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.duration_seconds", li, ri)).(int)
-					if t == "daily_restriction" && ds >= 3600*24 {
-						return fmt.Errorf("duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
+		CustomizeDiff: customdiff.All(
+			func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+				ln := diff.Get("layer.#").(int)
+				for li := 0; li <= ln; li++ {
+					rn := diff.Get(fmt.Sprintf("layer.%d.restriction.#", li)).(int)
+					for ri := 0; ri <= rn; ri++ {
+						t := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.type", li, ri)).(string)
+						isStartDayOfWeekSetWhenDailyRestrictionType := t == "daily_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) != 0
+						if isStartDayOfWeekSetWhenDailyRestrictionType {
+							return fmt.Errorf("start_day_of_week must only be set for a weekly_restriction schedule restriction type")
+						}
+						isStartDayOfWeekNotSetWhenWeeklyRestrictionType := t == "weekly_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) == 0
+						if isStartDayOfWeekNotSetWhenWeeklyRestrictionType {
+							return fmt.Errorf("start_day_of_week must be set for a weekly_restriction schedule restriction type")
+						}
+						ds := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.duration_seconds", li, ri)).(int)
+						if t == "daily_restriction" && ds >= 3600*24 {
+							return fmt.Errorf("duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
+						}
 					}
 				}
-			}
-			return nil
-		},
+				return nil
+			},
+			customizeDeletionProtectionDiff,
+			customizePreflightDiff("pagerduty_schedule", "schedules.write",
+				preflightAbility{ability: "teams", attribute: "teams"}),
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -198,6 +204,12 @@ func resourcePagerDutySchedule() *schema.Resource {
 					},
 				},
 			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -318,6 +330,10 @@ func resourcePagerDutyScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if !d.HasChangeExcept("deletion_protection") {
+		return nil
+	}
+
 	schedule, err := buildScheduleStruct(d)
 	if err != nil {
 		return err
@@ -390,6 +406,9 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
+	if err := checkDeletionProtection(d, "pagerduty_schedule"); err != nil {
+		return err
+	}
 	scheduleId := d.Id()
 
 	log.Printf("[INFO] Starting deletion process of Schedule %s", scheduleId)
@@ -11,6 +11,7 @@ import (
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,11 +20,17 @@ import (
 
 func resourcePagerDutyService() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourcePagerDutyServiceCreate,
+		CreateContext: resourcePagerDutyServiceCreateContext,
 		Read:          resourcePagerDutyServiceRead,
 		UpdateContext: resourcePagerDutyServiceUpdateContext,
 		Delete:        resourcePagerDutyServiceDelete,
-		CustomizeDiff: customizePagerDutyServiceDiff,
+		CustomizeDiff: customdiff.All(
+			customizePagerDutyServiceDiff,
+			customizeDeletionProtectionDiff,
+			customizePreflightDiff("pagerduty_service", "services.write",
+				preflightAbility{ability: "service_support_hours", attribute: "support_hours"},
+				preflightAbility{ability: "urgencies", attribute: "incident_urgency_rule"}),
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -307,6 +314,12 @@ func resourcePagerDutyService() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -496,22 +509,22 @@ func fetchService(d *schema.ResourceData, meta interface{}, errCallback func(err
 	})
 }
 
-func resourcePagerDutyServiceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourcePagerDutyServiceCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	service, err := buildServiceStruct(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating PagerDuty service %s", service.Name)
 
 	service, _, err = client.Services.Create(service)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err, resourcePagerDutyService().Schema)
 	}
 
 	d.SetId(service.ID)
@@ -520,7 +533,7 @@ func resourcePagerDutyServiceCreate(d *schema.ResourceData, meta interface{}) er
 	// alert_grouping_parameters will return empty.
 	time.Sleep(500 * time.Millisecond)
 
-	return fetchService(d, meta, genError)
+	return apiErrorDiagnostics(fetchService(d, meta, genError), nil)
 }
 
 func resourcePagerDutyServiceRead(d *schema.ResourceData, meta interface{}) error {
@@ -535,6 +548,10 @@ func resourcePagerDutyServiceUpdateContext(ctx context.Context, d *schema.Resour
 		return
 	}
 
+	if !d.HasChangeExcept("deletion_protection") {
+		return
+	}
+
 	service, err := buildServiceStruct(d)
 	if err != nil {
 		diags = diag.FromErr(err)
@@ -545,7 +562,7 @@ func resourcePagerDutyServiceUpdateContext(ctx context.Context, d *schema.Resour
 
 	_, _, err = client.Services.Update(d.Id(), service)
 	if err != nil {
-		diags = diag.FromErr(handleNotFoundError(err, d))
+		diags = apiErrorDiagnostics(handleNotFoundError(err, d), resourcePagerDutyService().Schema)
 		return
 	}
 
@@ -570,6 +587,10 @@ func resourcePagerDutyServiceDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_service"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty service %s", d.Id())
 
 	if _, err := client.Services.Delete(d.Id()); err != nil {
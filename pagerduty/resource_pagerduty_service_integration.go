@@ -23,9 +23,9 @@ const (
 
 func resourcePagerDutyServiceIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourcePagerDutyServiceIntegrationCreate,
+		CreateContext: resourcePagerDutyServiceIntegrationCreateContext,
 		Read:          resourcePagerDutyServiceIntegrationRead,
-		Update:        resourcePagerDutyServiceIntegrationUpdate,
+		UpdateContext: resourcePagerDutyServiceIntegrationUpdateContext,
 		Delete:        resourcePagerDutyServiceIntegrationDelete,
 		CustomizeDiff: customizeServiceIntegrationDiff(),
 		Importer: &schema.ResourceImporter{
@@ -89,6 +89,19 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"key_rotation": keyRotationSchema(),
+			"rotated_integration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotated_integration_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotation_started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"html_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -357,6 +370,12 @@ func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 		if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" && diff.NewValueKnown("integration_email") {
 			return errors.New(errEmailIntegrationMustHaveEmail)
 		}
+		if err := checkServiceIntegrationEmailRules(diff); err != nil {
+			return err
+		}
+		if err := customizeServiceIntegrationKeyRotationDiff(diff); err != nil {
+			return err
+		}
 
 		// All this custom diff logic is needed because the email_filters API
 		// response returns a default value for its structure even when this
@@ -734,6 +753,10 @@ func fetchPagerDutyServiceIntegration(d *schema.ResourceData, meta interface{},
 	})
 }
 
+func resourcePagerDutyServiceIntegrationCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return apiErrorDiagnostics(resourcePagerDutyServiceIntegrationCreate(d, meta), resourcePagerDutyServiceIntegration().Schema)
+}
+
 func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -771,7 +794,17 @@ func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta inte
 
 func resourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading PagerDuty service integration %s", d.Id())
-	return fetchPagerDutyServiceIntegration(d, meta, handleNotFoundError)
+	if err := fetchPagerDutyServiceIntegration(d, meta, handleNotFoundError); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return fetchRotatedServiceIntegration(d, meta)
+}
+
+func resourcePagerDutyServiceIntegrationUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return apiErrorDiagnostics(resourcePagerDutyServiceIntegrationUpdate(d, meta), resourcePagerDutyServiceIntegration().Schema)
 }
 
 func resourcePagerDutyServiceIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -793,6 +826,26 @@ func resourcePagerDutyServiceIntegrationUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	// Keep a pending rotated integration in sync with the current one.
+	if oldRotatedID, rotatedID := d.GetChange("rotated_integration_id"); rotatedID.(string) != "" && oldRotatedID == rotatedID {
+		rotated := *serviceIntegration
+		rotated.IntegrationKey = ""
+
+		log.Printf("[INFO] Updating rotated PagerDuty service integration %s", rotatedID)
+
+		if _, _, err := client.Services.UpdateIntegration(service, rotatedID.(string), &rotated); err != nil {
+			return err
+		}
+	}
+
+	rotated, err := rotateServiceIntegrationKey(d, meta, serviceIntegration)
+	if err != nil {
+		return err
+	}
+	if rotated {
+		return fetchPagerDutyServiceIntegration(d, meta, genError)
+	}
+
 	return nil
 }
 
@@ -810,6 +863,14 @@ func resourcePagerDutyServiceIntegrationDelete(d *schema.ResourceData, meta inte
 		return err
 	}
 
+	if rotatedID := d.Get("rotated_integration_id").(string); rotatedID != "" {
+		log.Printf("[INFO] Removing rotated PagerDuty service integration %s", rotatedID)
+
+		if err := deleteRotatedServiceIntegration(client, service, rotatedID); err != nil {
+			return err
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -110,6 +110,61 @@ func TestAccPagerDutyServiceIntegrationGeneric_Basic(t *testing.T) {
 		},
 	})
 }
+func TestAccPagerDutyServiceIntegration_KeyRotation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationKeyRotationConfig(username, email, escalationPolicy, service, serviceIntegration, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "rotated_integration_id", ""),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationKeyRotationConfig(username, email, escalationPolicy, service, serviceIntegration, `
+  key_rotation {
+    id = "2026-10"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service_integration.foo", "rotated_integration_id"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service_integration.foo", "rotated_integration_key"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service_integration.foo", "rotation_started_at"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationKeyRotationConfig(username, email, escalationPolicy, service, serviceIntegration, `
+  key_rotation {
+    id       = "2026-10"
+    cut_over = true
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "rotated_integration_id", ""),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "rotated_integration_key", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyServiceIntegrationEmail_Filters(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -402,6 +457,90 @@ func TestAccPagerDutyServiceIntegration_GenericEmailNoFilters(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceIntegrationEmail_InvalidRules(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEmailRulesConfig(`
+  email_filter {
+    subject_mode  = "match"
+    subject_regex = "[ALERT"
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`email_filter.0.subject_regex: error parsing regexp`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEmailRulesConfig(`
+  email_filter {
+    body_mode = "no-match"
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`a regex is required when body_mode is no-match`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEmailRulesConfig(`
+  email_parser {
+    action = "trigger"
+    match_predicate {
+      type = "any"
+      predicate {
+        type = "not"
+        predicate {
+          type    = "regex"
+          part    = "body"
+          matcher = "(unclosed"
+        }
+      }
+    }
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`predicate.0.predicate.0.matcher: error parsing regexp`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEmailRulesConfig(`
+  email_parser {
+    action = "trigger"
+    match_predicate {
+      type = "all"
+      predicate {
+        type    = "contains"
+        part    = "subject"
+        matcher = "foo"
+      }
+    }
+    value_extractor {
+      type       = "between"
+      part       = "subject"
+      value_name = "incident_key"
+    }
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`starts_after is required for a between value extractor`),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceIntegrationEmailRulesConfig(rules string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_service_integration" "foo" {
+  name                    = "foo"
+  service                 = "PSERVIC"
+  type                    = "generic_email_inbound_integration"
+  integration_email       = "foo@example.pagerduty.com"
+  email_incident_creation = "use_rules"
+  email_filter_mode       = "and-rules-email"
+%s
+}
+`, rules)
+}
+
 func testAccCheckPagerDutyServiceIntegrationDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -588,6 +727,50 @@ resource "pagerduty_service_integration" "foo" {
 `, username, email, escalationPolicy, service, serviceIntegration)
 }
 
+func testAccCheckPagerDutyServiceIntegrationKeyRotationConfig(username, email, escalationPolicy, service, serviceIntegration, keyRotation string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name    = "%s"
+  service = pagerduty_service.foo.id
+  type    = "events_api_v2_inbound_integration"
+%s
+}
+`, username, email, escalationPolicy, service, serviceIntegration, keyRotation)
+}
+
 func testAccCheckPagerDutyServiceIntegrationGenericConfigUpdated(username, email, escalationPolicy, service, serviceIntegration string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -8,6 +9,8 @@ import (
 	"time"
 
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -15,13 +18,19 @@ import (
 
 func resourcePagerDutyUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyUserCreate,
-		Read:   resourcePagerDutyUserRead,
-		Update: resourcePagerDutyUserUpdate,
-		Delete: resourcePagerDutyUserDelete,
+		CreateContext: resourcePagerDutyUserCreateContext,
+		Read:          resourcePagerDutyUserRead,
+		UpdateContext: resourcePagerDutyUserUpdateContext,
+		Delete:        resourcePagerDutyUserDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDefaultTagsDiff,
+			customizeDeletionProtectionDiff,
+			customizePreflightDiff("pagerduty_user", "users.write",
+				preflightAbility{ability: "teams", attribute: "teams"}),
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -107,6 +116,26 @@ func resourcePagerDutyUser() *schema.Resource {
 				Optional: true,
 				Type:     schema.TypeString,
 			},
+
+			"ignore_default_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -149,6 +178,10 @@ func buildUserStruct(d *schema.ResourceData) *pagerduty.User {
 	return user
 }
 
+func resourcePagerDutyUserCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return apiErrorDiagnostics(resourcePagerDutyUserCreate(d, meta), resourcePagerDutyUser().Schema)
+}
+
 func resourcePagerDutyUserCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -187,7 +220,7 @@ func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] pooh Reading PagerDuty user %s", d.Id())
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
 		user, err := client.Users.GetWithLicense(d.Id(), &pagerduty.GetUserOptions{})
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -224,6 +257,15 @@ func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
 
 		return nil
 	})
+	if retryErr != nil || d.Id() == "" {
+		return retryErr
+	}
+
+	return readDefaultTags(client, "users", d, meta)
+}
+
+func resourcePagerDutyUserUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return apiErrorDiagnostics(resourcePagerDutyUserUpdate(d, meta), resourcePagerDutyUser().Schema)
 }
 
 func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -240,22 +282,28 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 		user.License = nil
 	}
 
-	log.Printf("[INFO] Updating PagerDuty user %s", d.Id())
+	if d.HasChangesExcept("tags_all", "ignore_default_tags", "deletion_protection") {
+		log.Printf("[INFO] Updating PagerDuty user %s", d.Id())
 
-	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
-		if _, _, err := client.Users.Update(d.Id(), user); err != nil {
-			if isErrCode(err, 400) {
-				return retry.RetryableError(err)
-			}
+		// Retrying to give other resources (such as escalation policies) to delete
+		retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+			if _, _, err := client.Users.Update(d.Id(), user); err != nil {
+				if isErrCode(err, 400) {
+					return retry.RetryableError(err)
+				}
 
-			return retry.NonRetryableError(err)
+				return retry.NonRetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			time.Sleep(2 * time.Second)
+			return retryErr
 		}
-		return nil
-	})
-	if retryErr != nil {
-		time.Sleep(2 * time.Second)
-		return retryErr
+	}
+
+	if err := applyDefaultTags(client, "users", d); err != nil {
+		return err
 	}
 
 	if d.HasChange("teams") {
@@ -307,6 +355,10 @@ func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if err := checkDeletionProtection(d, "pagerduty_user"); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting PagerDuty user %s", d.Id())
 
 	// Retrying to give other resources (such as escalation policies) to delete
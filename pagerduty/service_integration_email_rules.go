@@ -0,0 +1,311 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+var serviceIntegrationEmailFilterParts = []string{"subject", "body", "from_email"}
+
+// checkServiceIntegrationEmailRules compiles the regexes of the email filters
+// and parsers of a service integration the way PagerDuty does with RE2, and
+// checks the arguments each predicate and value extractor type needs. Values
+// not known yet are skipped.
+func checkServiceIntegrationEmailRules(diff *schema.ResourceDiff) error {
+	fn := diff.Get("email_filter.#").(int)
+	for i := 0; i < fn; i++ {
+		prefix := fmt.Sprintf("email_filter.%d", i)
+		for _, part := range serviceIntegrationEmailFilterParts {
+			modeAttr, regexAttr := fmt.Sprintf("%s.%s_mode", prefix, part), fmt.Sprintf("%s.%s_regex", prefix, part)
+			if !diff.NewValueKnown(regexAttr) {
+				continue
+			}
+			mode, regex := diff.Get(modeAttr).(string), diff.Get(regexAttr).(string)
+			if regex == "" {
+				if (mode == "match" || mode == "no-match") && diff.NewValueKnown(modeAttr) {
+					return fmt.Errorf("Invalid configuration in %s: a regex is required when %s_mode is %s", regexAttr, part, mode)
+				}
+				continue
+			}
+			if _, err := regexp.Compile(regex); err != nil {
+				return fmt.Errorf("Invalid configuration in %s: %s", regexAttr, err)
+			}
+		}
+	}
+
+	pn := diff.Get("email_parser.#").(int)
+	for i := 0; i < pn; i++ {
+		prefix := fmt.Sprintf("email_parser.%d", i)
+
+		predicatesPrefix := prefix + ".match_predicate.0.predicate"
+		n := diff.Get(predicatesPrefix + ".#").(int)
+		for j := 0; j < n; j++ {
+			if err := checkServiceIntegrationEmailPredicate(diff, fmt.Sprintf("%s.%d", predicatesPrefix, j), true); err != nil {
+				return err
+			}
+		}
+
+		en := diff.Get(prefix + ".value_extractor.#").(int)
+		for j := 0; j < en; j++ {
+			extractor := fmt.Sprintf("%s.value_extractor.%d", prefix, j)
+			if !diff.NewValueKnown(extractor + ".type") {
+				continue
+			}
+			switch diff.Get(extractor + ".type").(string) {
+			case "regex":
+				if !diff.NewValueKnown(extractor + ".regex") {
+					continue
+				}
+				regex := diff.Get(extractor + ".regex").(string)
+				if regex == "" {
+					return fmt.Errorf("Invalid configuration in %s.regex: a regex is required for a regex value extractor", extractor)
+				}
+				if _, err := regexp.Compile(regex); err != nil {
+					return fmt.Errorf("Invalid configuration in %s.regex: %s", extractor, err)
+				}
+			case "between":
+				if diff.NewValueKnown(extractor+".starts_after") && diff.Get(extractor+".starts_after").(string) == "" {
+					return fmt.Errorf("Invalid configuration in %s.starts_after: starts_after is required for a between value extractor", extractor)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkServiceIntegrationEmailPredicate checks a predicate of an email
+// parser. Only top level predicates can be of type `not`, which negate their
+// single nested predicate.
+func checkServiceIntegrationEmailPredicate(diff *schema.ResourceDiff, prefix string, topLevel bool) error {
+	if !diff.NewValueKnown(prefix + ".type") {
+		return nil
+	}
+	predicateType := diff.Get(prefix + ".type").(string)
+	nested := 0
+	if topLevel {
+		nested = diff.Get(prefix + ".predicate.#").(int)
+	}
+
+	if predicateType == "not" {
+		if nested != 1 {
+			return fmt.Errorf("Invalid configuration in %s: a not predicate must have exactly one nested predicate", prefix)
+		}
+		return checkServiceIntegrationEmailPredicate(diff, prefix+".predicate.0", false)
+	}
+	if nested > 0 {
+		return fmt.Errorf("Invalid configuration in %s: only a not predicate can have nested predicates", prefix)
+	}
+	for _, attr := range []string{"part", "matcher"} {
+		if diff.NewValueKnown(prefix+"."+attr) && diff.Get(prefix+"."+attr).(string) == "" {
+			return fmt.Errorf("Invalid configuration in %s.%s: %s is required for a %s predicate", prefix, attr, attr, predicateType)
+		}
+	}
+	if predicateType == "regex" && diff.NewValueKnown(prefix+".matcher") {
+		if _, err := regexp.Compile(diff.Get(prefix + ".matcher").(string)); err != nil {
+			return fmt.Errorf("Invalid configuration in %s.matcher: %s", prefix, err)
+		}
+	}
+	return nil
+}
+
+// serviceIntegrationEmail is a sample email sent to an email integration.
+type serviceIntegrationEmail struct {
+	Subject string
+	Body    string
+	From    string
+}
+
+// serviceIntegrationEmailResult is the outcome of processing an email.
+type serviceIntegrationEmailResult struct {
+	// Accepted is false when the email filters discard the email
+	Accepted bool
+	// Action is trigger, resolve or discard
+	Action string
+	// MatchedParser is the ID of the email parser applied, if any
+	MatchedParser int
+	Values        map[string]string
+}
+
+// processServiceIntegrationEmail runs an email through the email filters and
+// parsers of an integration, the way PagerDuty documents them. The email is
+// accepted depending on `email_filter_mode` and, when incidents are created
+// by rules, the first parser whose predicates match sets the action and
+// extracts values, or `email_parsing_fallback` applies.
+func processServiceIntegrationEmail(integration *pagerduty.Integration, email serviceIntegrationEmail) (*serviceIntegrationEmailResult, error) {
+	result := &serviceIntegrationEmailResult{Values: map[string]string{}}
+
+	accepted, err := filterServiceIntegrationEmail(integration.EmailFilterMode, integration.EmailFilters, email)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		result.Action = "discard"
+		return result, nil
+	}
+	result.Accepted = true
+
+	if integration.EmailIncidentCreation != "use_rules" {
+		result.Action = "trigger"
+		return result, nil
+	}
+
+	for i, parser := range integration.EmailParsers {
+		if parser.MatchPredicate == nil {
+			continue
+		}
+		matched, err := matchServiceIntegrationEmailPredicates(parser.MatchPredicate.Type, parser.MatchPredicate.Predicates, email)
+		if err != nil {
+			return nil, fmt.Errorf("email parser %d: %w", i, err)
+		}
+		if !matched {
+			continue
+		}
+
+		result.Action = parser.Action
+		if parser.ID != nil {
+			result.MatchedParser = *parser.ID
+		}
+		for _, extractor := range parser.ValueExtractors {
+			value, ok, err := extractServiceIntegrationEmailValue(extractor, email)
+			if err != nil {
+				return nil, fmt.Errorf("email parser %d: %w", i, err)
+			}
+			if ok {
+				result.Values[extractor.ValueName] = value
+			}
+		}
+		return result, nil
+	}
+
+	result.Action = "trigger"
+	if integration.EmailParsingFallback == "discard" {
+		result.Action = "discard"
+	}
+	return result, nil
+}
+
+func filterServiceIntegrationEmail(mode string, filters []*pagerduty.EmailFilter, email serviceIntegrationEmail) (bool, error) {
+	if mode != "or-rules-email" && mode != "and-rules-email" {
+		return true, nil
+	}
+
+	for _, f := range filters {
+		matched := true
+		for _, c := range []struct{ mode, regex, value string }{
+			{f.SubjectMode, f.SubjectRegex, email.Subject},
+			{f.BodyMode, f.BodyRegex, email.Body},
+			{f.FromEmailMode, f.FromEmailRegex, email.From},
+		} {
+			if c.mode != "match" && c.mode != "no-match" {
+				continue
+			}
+			re, err := regexp.Compile(c.regex)
+			if err != nil {
+				return false, fmt.Errorf("email filter %s: %w", f.ID, err)
+			}
+			if re.MatchString(c.value) != (c.mode == "match") {
+				matched = false
+			}
+		}
+
+		if matched && mode == "or-rules-email" {
+			return true, nil
+		}
+		if !matched && mode == "and-rules-email" {
+			return false, nil
+		}
+	}
+	return mode == "and-rules-email", nil
+}
+
+func matchServiceIntegrationEmailPredicates(matchType string, predicates []*pagerduty.Predicate, email serviceIntegrationEmail) (bool, error) {
+	for _, p := range predicates {
+		matched, err := matchServiceIntegrationEmailPredicate(p, email)
+		if err != nil {
+			return false, err
+		}
+		if matched && matchType == "any" {
+			return true, nil
+		}
+		if !matched && matchType != "any" {
+			return false, nil
+		}
+	}
+	return matchType != "any", nil
+}
+
+func matchServiceIntegrationEmailPredicate(p *pagerduty.Predicate, email serviceIntegrationEmail) (bool, error) {
+	if p.Type == "not" {
+		if len(p.Predicates) == 0 {
+			return true, nil
+		}
+		matched, err := matchServiceIntegrationEmailPredicate(p.Predicates[0], email)
+		return !matched, err
+	}
+
+	value := serviceIntegrationEmailPart(p.Part, email)
+	switch p.Type {
+	case "contains":
+		return strings.Contains(value, p.Matcher), nil
+	case "exactly":
+		return value == p.Matcher, nil
+	case "regex":
+		re, err := regexp.Compile(p.Matcher)
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(value), nil
+	}
+	return false, fmt.Errorf("unsupported predicate type %q", p.Type)
+}
+
+// extractServiceIntegrationEmailValue returns the value an extractor gets
+// from an email, and false when it finds none. A regex extracts its first
+// capture group, or the whole match when it has none.
+func extractServiceIntegrationEmailValue(extractor *pagerduty.ValueExtractor, email serviceIntegrationEmail) (string, bool, error) {
+	value := serviceIntegrationEmailPart(extractor.Part, email)
+	switch extractor.Type {
+	case "entire":
+		return value, true, nil
+	case "between":
+		_, after, found := strings.Cut(value, extractor.StartsAfter)
+		if !found {
+			return "", false, nil
+		}
+		if extractor.EndsBefore == "" {
+			return after, true, nil
+		}
+		before, _, found := strings.Cut(after, extractor.EndsBefore)
+		return before, found, nil
+	case "regex":
+		re, err := regexp.Compile(extractor.Regex)
+		if err != nil {
+			return "", false, err
+		}
+		m := re.FindStringSubmatch(value)
+		if m == nil {
+			return "", false, nil
+		}
+		if len(m) > 1 {
+			return m[1], true, nil
+		}
+		return m[0], true, nil
+	}
+	return "", false, fmt.Errorf("unsupported value extractor type %q", extractor.Type)
+}
+
+func serviceIntegrationEmailPart(part string, email serviceIntegrationEmail) string {
+	switch part {
+	case "subject":
+		return email.Subject
+	case "body":
+		return email.Body
+	case "from_addresses":
+		return email.From
+	}
+	return ""
+}
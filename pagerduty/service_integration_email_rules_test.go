@@ -0,0 +1,120 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestProcessServiceIntegrationEmail(t *testing.T) {
+	parserID := 7
+	integration := &pagerduty.Integration{
+		EmailIncidentCreation: "use_rules",
+		EmailFilterMode:       "or-rules-email",
+		EmailParsingFallback:  "discard",
+		EmailFilters: []*pagerduty.EmailFilter{
+			{ID: "F1", SubjectMode: "match", SubjectRegex: `^\[(ALERT|OK)\]`, BodyMode: "always", FromEmailMode: "always"},
+			{ID: "F2", SubjectMode: "always", BodyMode: "always", FromEmailMode: "match", FromEmailRegex: `@monitoring\.test$`},
+		},
+		EmailParsers: []*pagerduty.EmailParser{
+			{
+				ID:     &parserID,
+				Action: "resolve",
+				MatchPredicate: &pagerduty.MatchPredicate{
+					Type: "all",
+					Predicates: []*pagerduty.Predicate{
+						{Type: "contains", Part: "subject", Matcher: "[OK]"},
+						{Type: "not", Predicates: []*pagerduty.Predicate{{Type: "regex", Part: "body", Matcher: "(?i)ignore"}}},
+					},
+				},
+				ValueExtractors: []*pagerduty.ValueExtractor{
+					{Type: "between", Part: "subject", StartsAfter: "host=", EndsBefore: " ", ValueName: "incident_key"},
+					{Type: "regex", Part: "body", Regex: `region: (\w+)`, ValueName: "region"},
+					{Type: "entire", Part: "subject", ValueName: "summary"},
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		email      serviceIntegrationEmail
+		accepted   bool
+		action     string
+		values     map[string]string
+		fromParser bool
+	}{
+		"parsed": {
+			email:      serviceIntegrationEmail{Subject: "[OK] host=db-01 recovered", Body: "region: eu", From: "ops@example.test"},
+			accepted:   true,
+			action:     "resolve",
+			values:     map[string]string{"incident_key": "db-01", "region": "eu", "summary": "[OK] host=db-01 recovered"},
+			fromParser: true,
+		},
+		"negated predicate": {
+			email:    serviceIntegrationEmail{Subject: "[OK] host=db-01 recovered", Body: "IGNORE this one"},
+			accepted: true,
+			action:   "discard",
+			values:   map[string]string{},
+		},
+		"accepted by another filter": {
+			email:    serviceIntegrationEmail{Subject: "Disk full", From: "alerts@monitoring.test"},
+			accepted: true,
+			action:   "discard",
+			values:   map[string]string{},
+		},
+		"filtered out": {
+			email:    serviceIntegrationEmail{Subject: "Newsletter", From: "news@example.test"},
+			accepted: false,
+			action:   "discard",
+			values:   map[string]string{},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := processServiceIntegrationEmail(integration, c.email)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Accepted != c.accepted || result.Action != c.action {
+				t.Errorf("expected accepted %v and action %q, got %v and %q", c.accepted, c.action, result.Accepted, result.Action)
+			}
+			if c.fromParser != (result.MatchedParser == parserID) {
+				t.Errorf("unexpected matched parser %d", result.MatchedParser)
+			}
+			if len(result.Values) != len(c.values) {
+				t.Fatalf("expected values %v, got %v", c.values, result.Values)
+			}
+			for k, v := range c.values {
+				if result.Values[k] != v {
+					t.Errorf("expected %s to be %q, got %q", k, v, result.Values[k])
+				}
+			}
+		})
+	}
+}
+
+func TestProcessServiceIntegrationEmailWithoutRules(t *testing.T) {
+	integration := &pagerduty.Integration{
+		EmailIncidentCreation: "on_new_email",
+		EmailFilterMode:       "and-rules-email",
+		EmailFilters: []*pagerduty.EmailFilter{
+			{SubjectMode: "no-match", SubjectRegex: "(?i)test", BodyMode: "always", FromEmailMode: "always"},
+		},
+	}
+
+	result, err := processServiceIntegrationEmail(integration, serviceIntegrationEmail{Subject: "Disk full"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Accepted || result.Action != "trigger" {
+		t.Errorf("expected the email to trigger an incident, got %+v", result)
+	}
+
+	result, err = processServiceIntegrationEmail(integration, serviceIntegrationEmail{Subject: "This is a TEST"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Accepted || result.Action != "discard" {
+		t.Errorf("expected the email to be discarded, got %+v", result)
+	}
+}
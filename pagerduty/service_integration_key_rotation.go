@@ -0,0 +1,142 @@
+package pagerduty
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+const (
+	errKeyRotationEmailIntegration = "key_rotation is not supported for email integrations, their integration_email must be unique"
+)
+
+// serviceIntegrationKeyRotation rotates the integration key of a service
+// integration.
+var serviceIntegrationKeyRotation = keyRotation{
+	rotatedKey:      "rotated_integration_key",
+	cutOverComputed: []string{"integration_key", "html_url"},
+}
+
+func customizeServiceIntegrationKeyRotationDiff(diff *schema.ResourceDiff) error {
+	if _, ok := diff.GetOk("key_rotation"); ok && diff.Get("integration_email").(string) != "" {
+		return errors.New(errKeyRotationEmailIntegration)
+	}
+	return serviceIntegrationKeyRotation.customizeDiff(diff)
+}
+
+// rotateServiceIntegrationKey applies the planned key rotation change, if
+// any, and tells whether it did so.
+func rotateServiceIntegrationKey(d *schema.ResourceData, meta interface{}, serviceIntegration *pagerduty.Integration) (bool, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return false, err
+	}
+
+	service := d.Get("service").(string)
+	step, rotatedID := serviceIntegrationKeyRotation.plannedStep(d)
+
+	switch step {
+	case keyRotationAbandon:
+		log.Printf("[INFO] Abandoning key rotation of PagerDuty service integration %s", d.Id())
+
+		if err := deleteRotatedServiceIntegration(client, service, rotatedID); err != nil {
+			return false, err
+		}
+		return true, serviceIntegrationKeyRotation.setState(d, "", "", "")
+	case keyRotationCutOver:
+		log.Printf("[INFO] Cutting over key rotation of PagerDuty service integration %s to %s", d.Id(), rotatedID)
+
+		promoted := *serviceIntegration
+		promoted.IntegrationKey = ""
+		if _, _, err := client.Services.UpdateIntegration(service, rotatedID, &promoted); err != nil {
+			return false, err
+		}
+
+		// The rotated integration is the managed one from here on, so the
+		// old one is left for the user to delete if deleting it fails.
+		oldID := d.Id()
+		d.SetId(rotatedID)
+		if err := serviceIntegrationKeyRotation.setState(d, "", "", ""); err != nil {
+			return false, err
+		}
+		if err := deleteRotatedServiceIntegration(client, service, oldID); err != nil {
+			return false, fmt.Errorf("key rotation cut over to PagerDuty service integration %s, but the old integration %s couldn't be deleted: %w", rotatedID, oldID, err)
+		}
+		return true, nil
+	case keyRotationStart:
+		_, newRotation := d.GetChange("key_rotation.0.id")
+		log.Printf("[INFO] Starting key rotation %s of PagerDuty service integration %s", newRotation, d.Id())
+
+		rotated := *serviceIntegration
+		rotated.ID = ""
+		rotated.IntegrationKey = ""
+
+		var created *pagerduty.Integration
+		retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+			i, _, err := client.Services.CreateIntegration(service, &rotated)
+			if err != nil {
+				if isErrCode(err, http.StatusBadRequest) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			created = i
+			return nil
+		})
+		if retryErr != nil {
+			return false, retryErr
+		}
+
+		return true, serviceIntegrationKeyRotation.setState(d, created.ID, created.IntegrationKey, time.Now().UTC().Format(time.RFC3339))
+	}
+	return false, nil
+}
+
+// fetchRotatedServiceIntegration refreshes the key of the pending rotated
+// integration, forgetting the rotation when the integration was deleted.
+func fetchRotatedServiceIntegration(d *schema.ResourceData, meta interface{}) error {
+	rotatedID := d.Get("rotated_integration_id").(string)
+	if rotatedID == "" {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+		rotated, _, err := client.Services.GetIntegration(d.Get("service").(string), rotatedID, nil)
+		if err != nil {
+			if isErrCode(err, http.StatusNotFound) {
+				log.Printf("[WARN] Rotated PagerDuty service integration %s not found, forgetting the key rotation", rotatedID)
+				if err := serviceIntegrationKeyRotation.setState(d, "", "", ""); err != nil {
+					return retry.NonRetryableError(err)
+				}
+				return nil
+			}
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+
+		if err := d.Set("rotated_integration_key", rotated.IntegrationKey); err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func deleteRotatedServiceIntegration(client *pagerduty.Client, service, id string) error {
+	if _, err := client.Services.DeleteIntegration(service, id); err != nil && !isErrCode(err, http.StatusNotFound) {
+		return err
+	}
+	return nil
+}
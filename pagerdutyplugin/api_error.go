@@ -0,0 +1,58 @@
+package pagerduty
+
+import (
+	"context"
+	"strings"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// addAPIError adds an error of the PagerDuty API to `diags`, reporting each
+// field error of a validation failure on the attribute of resource `r` it
+// refers to. Errors not coming from the API are reported as is.
+func addAPIError(ctx context.Context, diags *diag.Diagnostics, r resource.Resource, summary string, err error) {
+	details, ok := util.ParseAPIError(err)
+	if !ok {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	matched := false
+	if details.Kind == util.APIErrorKindValidation && r != nil {
+		attributes := resourceAttributeNames(ctx, r)
+		for _, msg := range details.Errors {
+			name, ok := util.MatchAPIErrorAttribute(msg, attributes)
+			if !ok {
+				continue
+			}
+			diags.AddAttributeError(path.Root(name), summary, msg)
+			matched = true
+		}
+	}
+	if matched {
+		return
+	}
+
+	detail := err.Error()
+	if hint := details.Hint(); hint != "" {
+		detail = hint + "\n\n" + strings.TrimSuffix(detail, "\n\n"+hint)
+	}
+	diags.AddError(summary, detail)
+}
+
+func resourceAttributeNames(ctx context.Context, r resource.Resource) []string {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	names := make([]string, 0, len(resp.Schema.Attributes)+len(resp.Schema.Blocks))
+	for name := range resp.Schema.Attributes {
+		names = append(names, name)
+	}
+	for name := range resp.Schema.Blocks {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,57 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+// apiRequest sends a raw request to the API, for payloads the client library
+// doesn't model entirely. `in` is sent as the JSON body when not nil and the
+// JSON response is decoded into `out` when not nil. Error responses are
+// returned as a pagerduty.APIError.
+func apiRequest(ctx context.Context, client *pagerduty.Client, apiURL, method, path string, in, out interface{}) error {
+	url := strings.TrimSuffix(apiURL, "/") + path
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req, true)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := pagerduty.APIError{}
+		// A body which isn't a JSON error object leaves the error without
+		// details, reported by its status code only.
+		_ = json.Unmarshal(b, &apiErr)
+		apiErr.StatusCode = resp.StatusCode
+		return apiErr
+	}
+
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
@@ -31,6 +31,9 @@ type Config struct {
 	// The PagerDuty APP URL
 	AppURL string
 
+	// The PagerDuty Events API URL
+	EventsURL string
+
 	// The PagerDuty API V2 token
 	Token string
 
@@ -52,8 +55,20 @@ type Config struct {
 	// Parameters for fine-grained access control
 	AppOauthScopedToken *AppOauthScopedToken
 
+	// Labels of the tags assigned to every taggable resource
+	DefaultTags []string
+
+	// Reject any request that would modify the PagerDuty account
+	ReadOnly bool
+
+	// Value of `deletion_protection` for resources not configuring it
+	DefaultDeletionProtection bool
+
 	// API wrapper
 	client *pagerduty.Client
+
+	// Abilities of the account and scopes granted to the provider
+	capabilities util.AccountCapabilities
 }
 
 type AppOauthScopedToken struct {
@@ -84,12 +99,12 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	}
 	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
-
-	apiURL := c.APIURL
-	if c.APIURLOverride != "" {
-		apiURL = c.APIURLOverride
+	if c.ReadOnly {
+		httpClient.Transport = util.NewReadOnlyTransport(httpClient.Transport)
 	}
 
+	apiURL := c.apiURL()
+
 	maxRetries := 1
 	retryInterval := 60 // seconds
 
@@ -104,18 +119,24 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 		pagerduty.WithTerraformProvider(userAgentVersion),
 		pagerduty.WithRetryPolicy(maxRetries, retryInterval),
 	}
+	if c.EventsURL != "" {
+		clientOpts = append(clientOpts, pagerduty.WithV2EventsAPIEndpoint(c.EventsURL))
+	}
 
 	if c.AppOauthScopedToken != nil {
 		tokenFile := getTokenFilepath()
 		account := fmt.Sprintf("as_account-%s.%s", c.ServiceRegion, c.AppOauthScopedToken.Subdomain)
 		accountAndScopes := []string{account}
 		accountAndScopes = append(accountAndScopes, availableOauthScopes()...)
-		opt := pagerduty.WithScopedOAuthAppTokenSource(pagerduty.NewFileTokenSource(
-			ctx,
+		opt := pagerduty.WithScopedOAuthAppTokenSource(util.NewScopeRecordingTokenSource(
 			c.AppOauthScopedToken.ClientID,
-			c.AppOauthScopedToken.ClientSecret,
-			accountAndScopes,
-			tokenFile,
+			pagerduty.NewFileTokenSource(
+				ctx,
+				c.AppOauthScopedToken.ClientID,
+				c.AppOauthScopedToken.ClientSecret,
+				accountAndScopes,
+				tokenFile,
+			),
 		))
 		clientOpts = append(clientOpts, opt)
 	}
@@ -130,12 +151,14 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 		// Validate the credentials by calling the abilities endpoint,
 		// if we get a 401 response back we return an error to the user
 		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
-			if _, err := client.ListAbilitiesWithContext(ctx); err != nil {
+			response, err := client.ListAbilitiesWithContext(ctx)
+			if err != nil {
 				if util.IsAuthError(err) {
 					return retry.NonRetryableError(err)
 				}
 				return retry.RetryableError(err)
 			}
+			c.capabilities.SetAbilities(response.Abilities)
 			return nil
 		})
 		if err != nil {
@@ -148,6 +171,26 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 	return c.client, nil
 }
 
+// apiURL is the URL of the REST API the client talks to.
+func (c *Config) apiURL() string {
+	if c.APIURLOverride != "" {
+		return c.APIURLOverride
+	}
+	return c.APIURL
+}
+
+// Capabilities returns the abilities of the account and the OAuth scopes
+// granted to the provider, as far as they are known. Abilities are only
+// known once the credentials have been validated.
+func (c *Config) Capabilities() *util.AccountCapabilities {
+	if c.AppOauthScopedToken != nil {
+		if scopes, ok := util.GrantedScopes(c.AppOauthScopedToken.ClientID); ok {
+			c.capabilities.SetScopes(scopes)
+		}
+	}
+	return &c.capabilities
+}
+
 func WithHTTPClient(httpClient pagerduty.HTTPClient) pagerduty.ClientOptions {
 	return func(c *pagerduty.Client) {
 		if util.IsNilFunc(httpClient) {
@@ -248,6 +291,9 @@ func ConfigurePagerdutyClient(dst **pagerduty.Client, providerData any) diag.Dia
 	if providerData == nil {
 		return diags
 	}
+	if config, ok := providerData.(*Config); ok {
+		providerData = config.client
+	}
 	client, ok := providerData.(*pagerduty.Client)
 	if !ok {
 		diags.AddError(
@@ -270,3 +316,27 @@ func ConfigurePagerdutyClient(dst **pagerduty.Client, providerData any) diag.Dia
 	*dst = client
 	return diags
 }
+
+// ConfigurePagerdutyConfig sets the general configuration of the provider in
+// a pointer `dst`, for resources that depend on provider level settings
+// besides the API client.
+func ConfigurePagerdutyConfig(dst **Config, providerData any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if providerData == nil {
+		return diags
+	}
+	config, ok := providerData.(*Config)
+	if !ok {
+		diags.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *Config, got: %T."+
+					"Please report this issue to the provider developers.",
+				providerData,
+			),
+		)
+		return diags
+	}
+	*dst = config
+	return diags
+}
@@ -2,7 +2,14 @@ package pagerduty
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 )
 
 // Test config with an empty token
@@ -79,3 +86,43 @@ func TestConfigInsecureTls(t *testing.T) {
 		t.Fatalf("error: expected the client to not fail: %v", err)
 	}
 }
+
+// Test config with ReadOnly
+func TestConfigReadOnly(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"team":{"id":"P000000","name":"foo"}}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:               "foo",
+		APIURLOverride:      server.URL,
+		SkipCredsValidation: true,
+		ReadOnly:            true,
+	}
+
+	ctx := context.Background()
+	client, err := config.Client(ctx)
+	if err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+
+	if _, err := client.GetTeamWithContext(ctx, "P000000"); err != nil {
+		t.Fatalf("error: expected GET requests to be sent: %v", err)
+	}
+
+	_, err = client.CreateTeamWithContext(ctx, &pagerduty.Team{Name: "foo"})
+	if !util.IsBadRequestError(err) {
+		t.Fatalf("error: expected a bad request error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "read_only = true") {
+		t.Fatalf("error: expected the error to mention read_only, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(methods, []string{http.MethodGet}) {
+		t.Fatalf("error: expected only a GET request to reach the server, got: %v", methods)
+	}
+}
@@ -0,0 +1,289 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// dataSourceEscalationPath resolves who an escalation policy pages at a given
+// time, level after level, as an ordered list of escalation levels.
+type dataSourceEscalationPath struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ datasource.DataSourceWithConfigure      = (*dataSourceEscalationPath)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*dataSourceEscalationPath)(nil)
+)
+
+func (*dataSourceEscalationPath) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_escalation_path"
+}
+
+func (*dataSourceEscalationPath) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                schema.StringAttribute{Computed: true},
+			"service":           schema.StringAttribute{Optional: true},
+			"escalation_policy": schema.StringAttribute{Optional: true, Computed: true},
+			"at":                schema.StringAttribute{Optional: true, Computed: true},
+			"levels": schema.ListAttribute{
+				Computed:    true,
+				ElementType: escalationPathLevelObjectType,
+			},
+		},
+	}
+}
+
+func (d *dataSourceEscalationPath) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
+}
+
+func (d *dataSourceEscalationPath) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var model dataSourceEscalationPathModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !model.Service.IsUnknown() && !model.EscalationPolicy.IsUnknown() && model.Service.IsNull() == model.EscalationPolicy.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("service"), "Invalid configuration",
+			"exactly one of service and escalation_policy must be set")
+	}
+	if isKnownValue(model.At) {
+		if _, err := time.Parse(time.RFC3339, model.At.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("at"), "Invalid configuration",
+				fmt.Sprintf("at must be a timestamp in RFC3339 format, got: %s", model.At.ValueString()))
+		}
+	}
+}
+
+func (d *dataSourceEscalationPath) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataSourceEscalationPathModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	at := time.Now().UTC().Truncate(time.Second)
+	if !model.At.IsNull() {
+		t, err := time.Parse(time.RFC3339, model.At.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("at"), "Invalid configuration", err.Error())
+			return
+		}
+		at = t
+	}
+
+	policyID := model.EscalationPolicy.ValueString()
+	if model.EscalationPolicy.IsNull() {
+		log.Printf("[INFO] Reading PagerDuty service %s", model.Service)
+
+		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			service, err := d.client.GetServiceWithContext(ctx, model.Service.ValueString(), nil)
+			if err != nil {
+				if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			policyID = service.EscalationPolicy.ID
+			return nil
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty service %s", model.Service), err.Error())
+			return
+		}
+	}
+
+	log.Printf("[INFO] Resolving the escalation path of PagerDuty escalation policy %s at %s", policyID, at.Format(time.RFC3339))
+
+	ep, err := getEscalationPolicyPayload(ctx, d.client, d.config, policyID, false)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty escalation policy %s", policyID), err.Error())
+		return
+	}
+
+	levels, err := resolveEscalationPath(ep, at, func(scheduleID string, t time.Time) ([]string, error) {
+		return d.listScheduleOnCallUsers(ctx, scheduleID, t)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error resolving the escalation path of PagerDuty escalation policy %s", policyID), err.Error())
+		return
+	}
+
+	model.ID = types.StringValue(policyID)
+	model.EscalationPolicy = types.StringValue(policyID)
+	model.At = types.StringValue(at.Format(time.RFC3339))
+	model.Levels = flattenEscalationPathLevels(levels)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (d *dataSourceEscalationPath) listScheduleOnCallUsers(ctx context.Context, scheduleID string, at time.Time) ([]string, error) {
+	o := pagerduty.ListOnCallUsersOptions{
+		Since: at.Format(time.RFC3339),
+		Until: at.Add(time.Second).Format(time.RFC3339),
+	}
+
+	var ids []string
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		users, err := d.client.ListOnCallUsersWithContext(ctx, scheduleID, o)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		ids = ids[:0]
+		for _, u := range users {
+			ids = append(ids, u.ID)
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// escalationPathLevel is an escalation rule reached by an incident, once per
+// loop of the escalation policy.
+type escalationPathLevel struct {
+	// Level is the position of the rule in the policy, starting at 1
+	Level int
+	// Loop is 0 for the first pass over the rules and increases with every
+	// loop of the policy
+	Loop   int
+	RuleID string
+	// StartsAfter is the number of minutes after the incident is triggered
+	// when the level is reached
+	StartsAfter              int
+	EscalationDelayInMinutes int
+	AssignmentStrategy       string
+	Schedules                []string
+	// Candidates are the users the level can notify: all of them, or only
+	// one in turn with the round robin assignment strategy
+	Candidates []string
+	// Users are the users notified when the level is reached, known unless
+	// the level assigns its candidates round robin
+	Users []string
+}
+
+// resolveEscalationPath walks the rules of an escalation policy, repeating
+// them `num_loops` times, and resolves the users each level notifies when it
+// is reached. Schedules are resolved with `onCall`, at the time the level is
+// reached after an incident triggered at `at`. Which candidate of a round
+// robin level is assigned depends on previous incidents, so its users are
+// left empty.
+func resolveEscalationPath(ep *escalationPolicyPayload, at time.Time, onCall func(scheduleID string, at time.Time) ([]string, error)) ([]escalationPathLevel, error) {
+	var levels []escalationPathLevel
+	startsAfter := 0
+	for loop := 0; loop <= ep.NumLoops; loop++ {
+		for i, rule := range ep.EscalationRules {
+			level := escalationPathLevel{
+				Level:                    i + 1,
+				Loop:                     loop,
+				RuleID:                   rule.ID,
+				StartsAfter:              startsAfter,
+				EscalationDelayInMinutes: rule.EscalationDelayInMinutes,
+				AssignmentStrategy:       "assign_to_everyone",
+				Schedules:                []string{},
+				Candidates:               []string{},
+			}
+			if rule.EscalationRuleAssignmentStrategy != nil && rule.EscalationRuleAssignmentStrategy.Type != "" {
+				level.AssignmentStrategy = rule.EscalationRuleAssignmentStrategy.Type
+			}
+
+			for _, target := range rule.Targets {
+				switch target.Type {
+				case "schedule_reference", "schedule":
+					level.Schedules = append(level.Schedules, target.ID)
+					users, err := onCall(target.ID, at.Add(time.Duration(startsAfter)*time.Minute))
+					if err != nil {
+						return nil, fmt.Errorf("schedule %s: %w", target.ID, err)
+					}
+					for _, u := range users {
+						if !slices.Contains(level.Candidates, u) {
+							level.Candidates = append(level.Candidates, u)
+						}
+					}
+				default:
+					if !slices.Contains(level.Candidates, target.ID) {
+						level.Candidates = append(level.Candidates, target.ID)
+					}
+				}
+			}
+			level.Users = level.Candidates
+			if level.AssignmentStrategy == "round_robin" {
+				level.Users = []string{}
+			}
+
+			levels = append(levels, level)
+			startsAfter += rule.EscalationDelayInMinutes
+		}
+	}
+	return levels, nil
+}
+
+func flattenEscalationPathLevels(levels []escalationPathLevel) types.List {
+	elements := make([]attr.Value, 0, len(levels))
+	for _, l := range levels {
+		schedules := make([]attr.Value, 0, len(l.Schedules))
+		for _, s := range l.Schedules {
+			schedules = append(schedules, types.StringValue(s))
+		}
+		candidates := make([]attr.Value, 0, len(l.Candidates))
+		for _, u := range l.Candidates {
+			candidates = append(candidates, types.StringValue(u))
+		}
+		users := make([]attr.Value, 0, len(l.Users))
+		for _, u := range l.Users {
+			users = append(users, types.StringValue(u))
+		}
+		elements = append(elements, types.ObjectValueMust(escalationPathLevelObjectType.AttrTypes, map[string]attr.Value{
+			"level":                       types.Int64Value(int64(l.Level)),
+			"loop":                        types.Int64Value(int64(l.Loop)),
+			"rule_id":                     types.StringValue(l.RuleID),
+			"starts_after_minutes":        types.Int64Value(int64(l.StartsAfter)),
+			"escalation_delay_in_minutes": types.Int64Value(int64(l.EscalationDelayInMinutes)),
+			"assignment_strategy":         types.StringValue(l.AssignmentStrategy),
+			"schedules":                   types.ListValueMust(types.StringType, schedules),
+			"candidates":                  types.ListValueMust(types.StringType, candidates),
+			"users":                       types.ListValueMust(types.StringType, users),
+		}))
+	}
+	return types.ListValueMust(escalationPathLevelObjectType, elements)
+}
+
+type dataSourceEscalationPathModel struct {
+	ID               types.String `tfsdk:"id"`
+	Service          types.String `tfsdk:"service"`
+	EscalationPolicy types.String `tfsdk:"escalation_policy"`
+	At               types.String `tfsdk:"at"`
+	Levels           types.List   `tfsdk:"levels"`
+}
+
+var escalationPathLevelObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"level":                       types.Int64Type,
+		"loop":                        types.Int64Type,
+		"rule_id":                     types.StringType,
+		"starts_after_minutes":        types.Int64Type,
+		"escalation_delay_in_minutes": types.Int64Type,
+		"assignment_strategy":         types.StringType,
+		"schedules":                   types.ListType{ElemType: types.StringType},
+		"candidates":                  types.ListType{ElemType: types.StringType},
+		"users":                       types.ListType{ElemType: types.StringType},
+	},
+}
@@ -0,0 +1,203 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResolveEscalationPath(t *testing.T) {
+	at := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	ep := &escalationPolicyPayload{
+		NumLoops: 1,
+		EscalationRules: []*escalationPolicyRulePayload{
+			{
+				ID:                       "R1",
+				EscalationDelayInMinutes: 30,
+				Targets: []*escalationPolicyReference{
+					{ID: "SDAY", Type: "schedule_reference"},
+					{ID: "U1", Type: "user_reference"},
+				},
+			},
+			{
+				ID:                               "R2",
+				EscalationDelayInMinutes:         10,
+				EscalationRuleAssignmentStrategy: &escalationPolicyAssignmentStrategyPayload{Type: "round_robin"},
+				Targets: []*escalationPolicyReference{
+					{ID: "U2", Type: "user_reference"},
+					{ID: "U3", Type: "user_reference"},
+				},
+			},
+		},
+	}
+	// The day schedule hands off to U4 at 09:35.
+	onCall := func(scheduleID string, t time.Time) ([]string, error) {
+		if scheduleID != "SDAY" {
+			return nil, fmt.Errorf("unexpected schedule %s", scheduleID)
+		}
+		if t.Before(at.Add(35 * time.Minute)) {
+			return []string{"U1"}, nil
+		}
+		return []string{"U4"}, nil
+	}
+
+	levels, err := resolveEscalationPath(ep, at, onCall)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []escalationPathLevel{
+		{Level: 1, Loop: 0, RuleID: "R1", StartsAfter: 0, EscalationDelayInMinutes: 30, AssignmentStrategy: "assign_to_everyone", Schedules: []string{"SDAY"}, Candidates: []string{"U1"}, Users: []string{"U1"}},
+		{Level: 2, Loop: 0, RuleID: "R2", StartsAfter: 30, EscalationDelayInMinutes: 10, AssignmentStrategy: "round_robin", Schedules: []string{}, Candidates: []string{"U2", "U3"}, Users: []string{}},
+		{Level: 1, Loop: 1, RuleID: "R1", StartsAfter: 40, EscalationDelayInMinutes: 30, AssignmentStrategy: "assign_to_everyone", Schedules: []string{"SDAY"}, Candidates: []string{"U4", "U1"}, Users: []string{"U4", "U1"}},
+		{Level: 2, Loop: 1, RuleID: "R2", StartsAfter: 70, EscalationDelayInMinutes: 10, AssignmentStrategy: "round_robin", Schedules: []string{}, Candidates: []string{"U2", "U3"}, Users: []string{}},
+	}
+	if len(levels) != len(expected) {
+		t.Fatalf("expected %d levels, got %d: %+v", len(expected), len(levels), levels)
+	}
+	for i, e := range expected {
+		l := levels[i]
+		if l.Level != e.Level || l.Loop != e.Loop || l.RuleID != e.RuleID || l.StartsAfter != e.StartsAfter ||
+			l.EscalationDelayInMinutes != e.EscalationDelayInMinutes || l.AssignmentStrategy != e.AssignmentStrategy ||
+			!slices.Equal(l.Schedules, e.Schedules) || !slices.Equal(l.Candidates, e.Candidates) || !slices.Equal(l.Users, e.Users) {
+			t.Errorf("level %d: expected %+v, got %+v", i, e, l)
+		}
+	}
+
+	if got := flattenEscalationPathLevels(levels); len(got.Elements()) != len(expected) {
+		t.Errorf("expected %d flattened levels, got %d", len(expected), len(got.Elements()))
+	}
+}
+
+func TestDataSourceEscalationPathConfigure(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, typ := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["token"] = tftypes.NewValue(tftypes.String, "token")
+	values["skip_credentials_validation"] = tftypes.NewValue(tftypes.Bool, true)
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	d := &dataSourceEscalationPath{}
+	var dsResp datasource.ConfigureResponse
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: resp.DataSourceData}, &dsResp)
+	if dsResp.Diagnostics.HasError() {
+		t.Fatal(dsResp.Diagnostics)
+	}
+	if d.client == nil || d.config == nil {
+		t.Errorf("expected the client and the config to be set, got %v and %v", d.client, d.config)
+	}
+
+	// Every other data source must keep accepting the data sources data.
+	for _, newDataSource := range p.DataSources(ctx) {
+		ds, ok := newDataSource().(datasource.DataSourceWithConfigure)
+		if !ok {
+			continue
+		}
+		var dsResp datasource.ConfigureResponse
+		ds.Configure(ctx, datasource.ConfigureRequest{ProviderData: resp.DataSourceData}, &dsResp)
+		if dsResp.Diagnostics.HasError() {
+			t.Errorf("%T: %v", ds, dsResp.Diagnostics)
+		}
+	}
+}
+
+func TestAccDataSourcePagerDutyEscalationPath_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEscalationPathConfig(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pagerduty_escalation_path.foo", "escalation_policy", "pagerduty_escalation_policy.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_path.foo", "at", "2026-10-19T09:00:00Z"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_path.foo", "levels.#", "4"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_escalation_path.foo", "levels.0.users.0", "pagerduty_user.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_escalation_path.foo", "levels.0.candidates.0", "pagerduty_user.foo", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_path.foo", "levels.1.starts_after_minutes", "30"),
+					resource.TestCheckResourceAttr("data.pagerduty_escalation_path.foo", "levels.2.loop", "1"),
+				),
+			},
+			{
+				Config: `
+data "pagerduty_escalation_path" "foo" {
+  service           = "PSERVIC"
+  escalation_policy = "PESCPOL"
+}
+`,
+				ExpectError: regexp.MustCompile("exactly one of service and escalation_policy must be set"),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyEscalationPathConfig(username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 30
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_escalation_path" "foo" {
+  service = pagerduty_service.foo.id
+  at      = "2026-10-19T09:00:00Z"
+}
+`, username, email, escalationPolicy, service)
+}
@@ -0,0 +1,129 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// planDefaultTags returns the value of `tags_all` for a taggable resource
+// according to the provider's `default_tags` argument.
+func planDefaultTags(config *Config, ignore types.Bool) types.Set {
+	labels := []string{}
+	if config != nil && !ignore.ValueBool() {
+		labels = append(labels, config.DefaultTags...)
+	}
+	return stringSliceToSet(labels)
+}
+
+// applyDefaultTags assigns and removes tags on an entity so the assigned
+// default tags go from `prev` to `next`. Tags missing on the account are
+// created by the API when assigned by label.
+func applyDefaultTags(ctx context.Context, client *pagerduty.Client, entityType, entityID string, prev, next types.Set, diags *diag.Diagnostics) {
+	prevLabels := setToStringSlice(ctx, prev, diags)
+	nextLabels := setToStringSlice(ctx, next, diags)
+	if diags.HasError() {
+		return
+	}
+
+	assignments := &pagerduty.TagAssignments{}
+	for _, label := range nextLabels {
+		if !slices.Contains(prevLabels, label) {
+			assignments.Add = append(assignments.Add, &pagerduty.TagAssignment{Type: "tag", Label: label})
+		}
+	}
+
+	var remove []string
+	for _, label := range prevLabels {
+		if !slices.Contains(nextLabels, label) {
+			remove = append(remove, label)
+		}
+	}
+	if len(remove) > 0 {
+		tags, err := client.GetTagsForEntityPaginated(ctx, entityType, entityID, pagerduty.ListTagOptions{})
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error reading tags for %s entity with ID %s", entityType, entityID), err.Error())
+			return
+		}
+		for _, tag := range tags {
+			if slices.Contains(remove, tag.Label) {
+				assignments.Remove = append(assignments.Remove, &pagerduty.TagAssignment{Type: "tag_reference", TagID: tag.ID})
+			}
+		}
+	}
+
+	if len(assignments.Add) == 0 && len(assignments.Remove) == 0 {
+		return
+	}
+	log.Printf("[INFO] Updating default tags of PagerDuty %s entity with ID %s", entityType, entityID)
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		if err := client.AssignTagsWithContext(ctx, entityType, entityID, assignments); err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error assigning default tags for %s entity with ID %s", entityType, entityID), err.Error())
+	}
+}
+
+// readDefaultTags returns the managed default tags still assigned to an
+// entity, where managed tags are the ones in `current` plus the ones the
+// provider wants to assign. No request is made when no tag is managed.
+func readDefaultTags(ctx context.Context, client *pagerduty.Client, entityType, entityID string, current, planned types.Set, diags *diag.Diagnostics) types.Set {
+	managed := setToStringSlice(ctx, current, diags)
+	for _, label := range setToStringSlice(ctx, planned, diags) {
+		if !slices.Contains(managed, label) {
+			managed = append(managed, label)
+		}
+	}
+	if diags.HasError() || len(managed) == 0 {
+		return stringSliceToSet([]string{})
+	}
+
+	tags, err := client.GetTagsForEntityPaginated(ctx, entityType, entityID, pagerduty.ListTagOptions{})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading tags for %s entity with ID %s", entityType, entityID), err.Error())
+		return current
+	}
+
+	assigned := []string{}
+	for _, tag := range tags {
+		if slices.Contains(managed, tag.Label) {
+			assigned = append(assigned, tag.Label)
+		}
+	}
+	return stringSliceToSet(assigned)
+}
+
+func setToStringSlice(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	var list []string
+	if set.IsNull() || set.IsUnknown() {
+		return list
+	}
+	diags.Append(set.ElementsAs(ctx, &list, false)...)
+	return list
+}
+
+func stringSliceToSet(list []string) types.Set {
+	sort.Strings(list)
+	elements := make([]attr.Value, 0, len(list))
+	for _, v := range list {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
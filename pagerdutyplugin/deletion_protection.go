@@ -0,0 +1,27 @@
+package pagerduty
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planDeletionProtection returns the value of `deletion_protection` for a
+// resource not configuring it, according to the provider's
+// `default_deletion_protection` argument.
+func planDeletionProtection(config *Config) types.Bool {
+	return types.BoolValue(config != nil && config.DefaultDeletionProtection)
+}
+
+// checkDeletionProtection adds an error to `diags` when a resource can't be
+// deleted because its `deletion_protection` is enabled.
+func checkDeletionProtection(resourceType, id string, deletionProtection types.Bool, diags *diag.Diagnostics) {
+	if !deletionProtection.ValueBool() {
+		return
+	}
+	diags.AddError(
+		fmt.Sprintf("Cannot delete %s %s", resourceType, id),
+		"The resource has deletion_protection enabled. Set `deletion_protection = false` and apply the change before destroying it.",
+	)
+}
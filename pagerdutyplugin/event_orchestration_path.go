@@ -0,0 +1,380 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// eventOrchestrationPathSchema builds the schema of the resource of a type of
+// path, whose parent is given by the attribute `parent`, from the `actions`
+// blocks of its rules and its catch-all.
+func eventOrchestrationPathSchema(parent string, attributes map[string]schema.Attribute, ruleActions, catchAllActions schema.ListNestedBlock, setValidators, ruleValidators []validator.List) schema.Schema {
+	attributes["id"] = schema.StringAttribute{
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes[parent] = schema.StringAttribute{
+		Required:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+
+	ruleActions.Validators = append(ruleActions.Validators, eventOrchestrationPathRequiredBlockValidators...)
+	catchAllActions.Validators = append(catchAllActions.Validators, eventOrchestrationPathRequiredBlockValidators...)
+
+	return schema.Schema{
+		Version:    1,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"set": schema.ListNestedBlock{
+				Validators: append([]validator.List{listvalidator.SizeAtLeast(1)}, setValidators...),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Validators: ruleValidators,
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"id":       schema.StringAttribute{Computed: true},
+									"key":      schema.StringAttribute{Optional: true},
+									"label":    schema.StringAttribute{Optional: true},
+									"disabled": schema.BoolAttribute{Optional: true},
+								},
+								Blocks: map[string]schema.Block{
+									"condition": eventOrchestrationPathConditionBlock(),
+									"actions":   ruleActions,
+								},
+							},
+						},
+					},
+				},
+			},
+			"catch_all": schema.ListNestedBlock{
+				Validators: eventOrchestrationPathRequiredBlockValidators,
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"actions": catchAllActions,
+					},
+				},
+			},
+		},
+	}
+}
+
+// eventOrchestrationPathRequiredBlockValidators require a block of exactly
+// one item.
+var eventOrchestrationPathRequiredBlockValidators = []validator.List{
+	listvalidator.IsRequired(),
+	listvalidator.SizeAtMost(1),
+}
+
+func eventOrchestrationPathConditionBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"expression": schema.StringAttribute{Required: true},
+			},
+		},
+	}
+}
+
+func eventOrchestrationPathVariableBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name":  schema.StringAttribute{Required: true},
+				"path":  schema.StringAttribute{Required: true},
+				"type":  schema.StringAttribute{Required: true},
+				"value": schema.StringAttribute{Required: true},
+			},
+			Validators: []validator.Object{eventOrchestrationPathVariableValidator{}},
+		},
+	}
+}
+
+func eventOrchestrationPathExtractionBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"regex":    schema.StringAttribute{Optional: true},
+				"source":   schema.StringAttribute{Optional: true},
+				"target":   schema.StringAttribute{Required: true},
+				"template": schema.StringAttribute{Optional: true},
+			},
+			Validators: []validator.Object{eventOrchestrationPathExtractionValidator{}},
+		},
+	}
+}
+
+func eventOrchestrationPathIncidentCustomFieldUpdateBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id":    schema.StringAttribute{Required: true},
+				"value": schema.StringAttribute{Required: true},
+			},
+		},
+	}
+}
+
+func eventOrchestrationPathAutomationActionBlock() schema.ListNestedBlock {
+	object := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"key":   schema.StringAttribute{Required: true},
+				"value": schema.StringAttribute{Required: true},
+			},
+		},
+	}
+	return schema.ListNestedBlock{
+		Validators: []validator.List{listvalidator.SizeAtMost(1)},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{Required: true},
+				"url":  schema.StringAttribute{Required: true},
+				"auto_send": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+				"trigger_types": eventOrchestrationPathTriggerTypesAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"header":    object,
+				"parameter": object,
+			},
+		},
+	}
+}
+
+func eventOrchestrationPathPagerdutyAutomationActionBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Validators: []validator.List{listvalidator.SizeAtMost(1)},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"action_id":     schema.StringAttribute{Required: true},
+				"trigger_types": eventOrchestrationPathTriggerTypesAttribute(),
+			},
+		},
+	}
+}
+
+// eventOrchestrationPathTriggerTypesAttribute is the `trigger_types` of
+// automation actions, defaulted by the API.
+func eventOrchestrationPathTriggerTypesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType:   types.StringType,
+		Optional:      true,
+		Computed:      true,
+		PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		Validators:    []validator.List{listvalidator.SizeAtMost(1)},
+	}
+}
+
+func eventOrchestrationPathSeverityAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:   true,
+		Validators: []validator.String{stringvalidator.OneOf("info", "error", "warning", "critical")},
+	}
+}
+
+func eventOrchestrationPathEventActionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:   true,
+		Validators: []validator.String{stringvalidator.OneOf("trigger", "resolve")},
+	}
+}
+
+// getEventOrchestrationPath reads a path, returning nil when its parent is
+// gone.
+func getEventOrchestrationPath(ctx context.Context, client *pagerduty.Client, apiURL, pathType, parent string) (*eventOrchestrationPath, error) {
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+	var orchestrationPath *eventOrchestrationPath
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		p, _, err := eventOrchestrationPathRequest(ctx, client, apiURL, http.MethodGet, pathType, parent, nil)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			// The API answers 403 instead of 404 for the path of a deleted service.
+			if util.IsNotFoundError(err) || (pathType == "service" && isForbiddenError(err)) {
+				return nil
+			}
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		orchestrationPath = p
+		return nil
+	})
+	return orchestrationPath, err
+}
+
+// putEventOrchestrationPath updates a path, adding the warnings of the API
+// to diags.
+func putEventOrchestrationPath(ctx context.Context, client *pagerduty.Client, apiURL, pathType, parent string, orchestrationPath *eventOrchestrationPath, diags *diag.Diagnostics) *eventOrchestrationPath {
+	log.Printf("[INFO] Updating PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+	var result *eventOrchestrationPath
+	var warnings []eventOrchestrationPathWarning
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		p, w, err := eventOrchestrationPathRequest(ctx, client, apiURL, http.MethodPut, pathType, parent, orchestrationPath)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		result, warnings = p, w
+		return nil
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error updating Event Orchestration Path of type %s for %s", pathType, parent), err.Error())
+		return nil
+	}
+
+	for _, w := range warnings {
+		diags.AddWarning(w.Message, fmt.Sprintf("Feature: %s\nFeature Type: %s\nRule ID: %s\nWarning Type: %s", w.Feature, w.FeatureType, w.RuleID, w.WarningType))
+	}
+	return result
+}
+
+// deleteEventOrchestrationPath resets a path, which can't be deleted, to an
+// empty path.
+func deleteEventOrchestrationPath(ctx context.Context, client *pagerduty.Client, apiURL, pathType, parent string, diags *diag.Diagnostics) {
+	log.Printf("[INFO] Deleting PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		_, _, err := eventOrchestrationPathDocumentRequest(ctx, client, apiURL, http.MethodPut, pathType, parent, emptyEventOrchestrationPathDocument(pathType))
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			if util.IsNotFoundError(err) || (pathType == "service" && isForbiddenError(err)) {
+				return nil
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error deleting Event Orchestration Path of type %s for %s", pathType, parent), err.Error())
+	}
+}
+
+type eventOrchestrationPathWarning struct {
+	Feature     string `json:"feature"`
+	FeatureType string `json:"feature_type"`
+	Message     string `json:"message"`
+	RuleID      string `json:"rule_id"`
+	WarningType string `json:"warning_type"`
+}
+
+func eventOrchestrationPathRequest(ctx context.Context, client *pagerduty.Client, apiURL, method, pathType, parent string, orchestrationPath *eventOrchestrationPath) (*eventOrchestrationPath, []eventOrchestrationPathWarning, error) {
+	format, ok := eventOrchestrationPathTypes[pathType]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown Event Orchestration Path type %q", pathType)
+	}
+
+	var in interface{}
+	if orchestrationPath != nil {
+		in = map[string]interface{}{"orchestration_path": orchestrationPath}
+	}
+
+	var payload struct {
+		OrchestrationPath *eventOrchestrationPath         `json:"orchestration_path"`
+		Warnings          []eventOrchestrationPathWarning `json:"warnings"`
+	}
+	if err := apiRequest(ctx, client, apiURL, method, fmt.Sprintf(format, parent), in, &payload); err != nil {
+		return nil, nil, err
+	}
+	if payload.OrchestrationPath == nil {
+		return nil, nil, fmt.Errorf("no Event Orchestration Path of type %s found for %s", pathType, parent)
+	}
+	return payload.OrchestrationPath, payload.Warnings, nil
+}
+
+// Kinds of the objects referenced by the actions of orchestration rules.
+const (
+	eventOrchestrationReferenceService          = "Service"
+	eventOrchestrationReferenceEscalationPolicy = "Escalation Policy"
+	eventOrchestrationReferenceAutomationAction = "Automation Action"
+)
+
+var eventOrchestrationReferencePaths = map[string]string{
+	eventOrchestrationReferenceService:          "/services/%s",
+	eventOrchestrationReferenceEscalationPolicy: "/escalation_policies/%s",
+	eventOrchestrationReferenceAutomationAction: "/automation_actions/actions/%s",
+}
+
+// eventOrchestrationReference is an attribute of the actions of a rule
+// holding the ID of an object of kind.
+type eventOrchestrationReference struct {
+	path path.Path
+	kind string
+	id   types.String
+}
+
+// checkEventOrchestrationReferences fails the plan of rules referencing
+// objects which don't exist. Only known values are checked and errors other
+// than not found are left to the API.
+func checkEventOrchestrationReferences(ctx context.Context, client *pagerduty.Client, apiURL string, references []eventOrchestrationReference, diags *diag.Diagnostics) {
+	found := map[string]bool{}
+	for _, ref := range references {
+		id := ref.id.ValueString()
+		if ref.id.IsUnknown() || id == "" || (ref.kind == eventOrchestrationReferenceService && id == "unrouted") {
+			continue
+		}
+
+		exists, ok := found[ref.kind+"/"+id]
+		if !ok {
+			err := apiRequest(ctx, client, apiURL, http.MethodGet, fmt.Sprintf(eventOrchestrationReferencePaths[ref.kind], id), nil, nil)
+			if err != nil && !util.IsNotFoundError(err) {
+				log.Printf("[WARN] Could not check that %s %s exists: %s", ref.kind, id, err)
+			}
+			exists = !util.IsNotFoundError(err)
+			found[ref.kind+"/"+id] = exists
+		}
+		if !exists {
+			diags.AddAttributeError(ref.path, "Invalid Event Orchestration rule actions", fmt.Sprintf("%s %q doesn't exist", ref.kind, id))
+		}
+	}
+}
+
+// eventOrchestrationPathActionsPaths returns the path of the `actions` block
+// of each rule of sets, in order, and of the catch-all.
+func eventOrchestrationPathActionsPaths[A any](sets []eventOrchestrationPathSetModel[A]) ([]path.Path, path.Path) {
+	var paths []path.Path
+	for si, s := range sets {
+		for ri := range s.Rules {
+			paths = append(paths, path.Root("set").AtListIndex(si).AtName("rule").AtListIndex(ri).AtName("actions").AtListIndex(0))
+		}
+	}
+	return paths, path.Root("catch_all").AtListIndex(0).AtName("actions").AtListIndex(0)
+}
+
+func isGoneError(err error) bool {
+	if details, ok := util.ParseAPIError(err); ok {
+		return details.StatusCode == http.StatusGone
+	}
+	return false
+}
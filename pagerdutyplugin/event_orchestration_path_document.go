@@ -0,0 +1,206 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PagerDuty/go-pagerduty"
+)
+
+// eventOrchestrationPathTypes are the types of the paths of an orchestration
+// and the format of their URL, relative to the API, given the ID of their
+// parent: an Event Orchestration or, for service paths, a Service.
+var eventOrchestrationPathTypes = map[string]string{
+	"global":   "/event_orchestrations/%s/global",
+	"router":   "/event_orchestrations/%s/router",
+	"service":  "/event_orchestrations/services/%s",
+	"unrouted": "/event_orchestrations/%s/unrouted",
+}
+
+// eventOrchestrationPathServerFields are set by the API and ignored when
+// comparing documents.
+var eventOrchestrationPathServerFields = []string{
+	"type", "self", "parent", "created_at", "created_by", "updated_at", "updated_by", "version",
+}
+
+// eventOrchestrationPathDocumentRequest sends a raw request for the path
+// document, so fields not yet modeled by the client library are kept.
+func eventOrchestrationPathDocumentRequest(ctx context.Context, client *pagerduty.Client, apiURL, method, pathType, parent string, document map[string]interface{}) (map[string]interface{}, []string, error) {
+	format, ok := eventOrchestrationPathTypes[pathType]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown Event Orchestration Path type %q", pathType)
+	}
+
+	var in interface{}
+	if document != nil {
+		in = map[string]interface{}{"orchestration_path": document}
+	}
+
+	var payload struct {
+		OrchestrationPath map[string]interface{} `json:"orchestration_path"`
+		Warnings          []struct {
+			Feature     string `json:"feature"`
+			FeatureType string `json:"feature_type"`
+			Message     string `json:"message"`
+			RuleID      string `json:"rule_id"`
+		} `json:"warnings"`
+	}
+	if err := apiRequest(ctx, client, apiURL, method, fmt.Sprintf(format, parent), in, &payload); err != nil {
+		return nil, nil, err
+	}
+	if payload.OrchestrationPath == nil {
+		return nil, nil, fmt.Errorf("no Event Orchestration Path of type %s found for %s", pathType, parent)
+	}
+
+	var warnings []string
+	for _, w := range payload.Warnings {
+		warnings = append(warnings, fmt.Sprintf("Rule %s: %s", w.RuleID, w.Message))
+	}
+	return payload.OrchestrationPath, warnings, nil
+}
+
+// decodeEventOrchestrationPathDocument decodes a path document, either bare
+// or wrapped in `orchestration_path` as exported by the API.
+func decodeEventOrchestrationPathDocument(document string) (map[string]interface{}, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, err
+	}
+	if wrapped, ok := doc["orchestration_path"].(map[string]interface{}); ok {
+		doc = wrapped
+	}
+	for _, k := range eventOrchestrationPathServerFields {
+		delete(doc, k)
+	}
+	return doc, nil
+}
+
+// normalizeEventOrchestrationPathDocument returns the canonical JSON of a
+// path document: fields set by the API, rule IDs and empty values, which
+// the API adds as defaults, are removed and keys are sorted.
+func normalizeEventOrchestrationPathDocument(document string) (string, error) {
+	doc, err := decodeEventOrchestrationPathDocument(document)
+	if err != nil {
+		return "", err
+	}
+	normalized, _ := normalizeEventOrchestrationPathValue(doc, "")
+	b, err := json.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// normalizeEventOrchestrationPathValue removes the empty values of a decoded
+// JSON value, reporting whether the value itself is empty. `key` is the key
+// of the value, or of the list holding it, in its parent object.
+func normalizeEventOrchestrationPathValue(v interface{}, key string) (interface{}, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, true
+	case bool:
+		return v, !v
+	case string:
+		return v, v == ""
+	case []interface{}:
+		list := []interface{}{}
+		for _, item := range v {
+			// Items of lists are kept, even empty, as their position matters.
+			n, _ := normalizeEventOrchestrationPathValue(item, key)
+			list = append(list, n)
+		}
+		return list, len(list) == 0
+	case map[string]interface{}:
+		obj := map[string]interface{}{}
+		for k, item := range v {
+			// Rule IDs are assigned by the API.
+			if k == "id" && key == "rules" {
+				continue
+			}
+			if n, empty := normalizeEventOrchestrationPathValue(item, k); !empty {
+				obj[k] = n
+			}
+		}
+		return obj, len(obj) == 0
+	}
+	return v, false
+}
+
+// emptyEventOrchestrationPathDocument is the document a path is reset to
+// when its resource is destroyed.
+func emptyEventOrchestrationPathDocument(pathType string) map[string]interface{} {
+	actions := map[string]interface{}{}
+	if pathType == "router" {
+		actions["route_to"] = "unrouted"
+	}
+	return map[string]interface{}{
+		"sets":      []interface{}{map[string]interface{}{"id": "start", "rules": []interface{}{}}},
+		"catch_all": map[string]interface{}{"actions": actions},
+	}
+}
+
+// keepEventOrchestrationPathRuleIDs sets on the rules of a document the IDs
+// of the rules of the same set they match in the path it replaces, by label
+// or in order, so that updating a path doesn't change the IDs of its rules.
+// Rules given an ID in the document keep it.
+func keepEventOrchestrationPathRuleIDs(prior, document map[string]interface{}) {
+	priorRules := map[string][]map[string]interface{}{}
+	for _, s := range eventOrchestrationPathDocumentList(prior, "sets") {
+		id, _ := s["id"].(string)
+		priorRules[id] = eventOrchestrationPathDocumentList(s, "rules")
+	}
+
+	explicit := map[string]bool{}
+	for _, s := range eventOrchestrationPathDocumentList(document, "sets") {
+		for _, r := range eventOrchestrationPathDocumentList(s, "rules") {
+			if ruleID, _ := r["id"].(string); ruleID != "" {
+				explicit[ruleID] = true
+			}
+		}
+	}
+
+	for _, s := range eventOrchestrationPathDocumentList(document, "sets") {
+		id, _ := s["id"].(string)
+		rules := eventOrchestrationPathDocumentList(s, "rules")
+
+		var unidentified []map[string]interface{}
+		var new []eventOrchestrationPathRuleIdentity
+		for _, r := range rules {
+			if ruleID, _ := r["id"].(string); ruleID != "" {
+				continue
+			}
+			label, _ := r["label"].(string)
+			unidentified = append(unidentified, r)
+			new = append(new, eventOrchestrationPathRuleIdentity{label: label})
+		}
+
+		var old []eventOrchestrationPathRuleIdentity
+		for _, r := range priorRules[id] {
+			ruleID, _ := r["id"].(string)
+			label, _ := r["label"].(string)
+			if !explicit[ruleID] {
+				old = append(old, eventOrchestrationPathRuleIdentity{id: ruleID, label: label})
+			}
+		}
+
+		for i, ruleID := range matchEventOrchestrationPathRuleIDs(old, new) {
+			if ruleID != "" {
+				unidentified[i]["id"] = ruleID
+			}
+		}
+	}
+}
+
+// eventOrchestrationPathDocumentList returns the objects of a list of a
+// decoded document, skipping anything else.
+func eventOrchestrationPathDocumentList(obj map[string]interface{}, key string) []map[string]interface{} {
+	items, _ := obj[key].([]interface{})
+	list := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok {
+			list = append(list, m)
+		}
+	}
+	return list
+}
@@ -0,0 +1,95 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*eventOrchestrationPathDocumentType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*eventOrchestrationPathDocument)(nil)
+)
+
+// eventOrchestrationPathDocumentType is a JSON document of an orchestration
+// path, two documents being equal when they only differ by values the API
+// adds by default, see normalizeEventOrchestrationPathDocument.
+type eventOrchestrationPathDocumentType struct {
+	basetypes.StringType
+}
+
+func (t eventOrchestrationPathDocumentType) String() string {
+	return "eventOrchestrationPathDocumentType"
+}
+
+func (t eventOrchestrationPathDocumentType) ValueType(ctx context.Context) attr.Value {
+	return eventOrchestrationPathDocument{}
+}
+
+func (t eventOrchestrationPathDocumentType) Equal(o attr.Type) bool {
+	other, ok := o.(eventOrchestrationPathDocumentType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t eventOrchestrationPathDocumentType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return eventOrchestrationPathDocument{StringValue: in}, nil
+}
+
+func (t eventOrchestrationPathDocumentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return eventOrchestrationPathDocument{StringValue: stringValue}, nil
+}
+
+type eventOrchestrationPathDocument struct {
+	basetypes.StringValue
+}
+
+func (v eventOrchestrationPathDocument) Type(_ context.Context) attr.Type {
+	return eventOrchestrationPathDocumentType{}
+}
+
+func (v eventOrchestrationPathDocument) Equal(o attr.Value) bool {
+	other, ok := o.(eventOrchestrationPathDocument)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v eventOrchestrationPathDocument) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(eventOrchestrationPathDocument)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T, got %T", v, newValuable))
+		return false, diags
+	}
+
+	a, err := normalizeEventOrchestrationPathDocument(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	b, err := normalizeEventOrchestrationPathDocument(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return a == b, diags
+}
+
+func newEventOrchestrationPathDocumentValue(document string) eventOrchestrationPathDocument {
+	return eventOrchestrationPathDocument{StringValue: basetypes.NewStringValue(document)}
+}
@@ -0,0 +1,667 @@
+package pagerduty
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// eventOrchestrationPath is an Event Orchestration Path as sent to and
+// returned by the API, for all the types of paths.
+type eventOrchestrationPath struct {
+	Parent   *eventOrchestrationPathReference `json:"parent,omitempty"`
+	Sets     []*eventOrchestrationPathSet     `json:"sets,omitempty"`
+	CatchAll *eventOrchestrationPathCatchAll  `json:"catch_all,omitempty"`
+}
+
+type eventOrchestrationPathReference struct {
+	ID string `json:"id,omitempty"`
+}
+
+type eventOrchestrationPathSet struct {
+	ID    string                        `json:"id,omitempty"`
+	Rules []*eventOrchestrationPathRule `json:"rules"`
+}
+
+type eventOrchestrationPathRule struct {
+	ID         string                             `json:"id,omitempty"`
+	Label      string                             `json:"label,omitempty"`
+	Conditions []*eventOrchestrationPathCondition `json:"conditions"`
+	Actions    *eventOrchestrationPathActions     `json:"actions,omitempty"`
+	Disabled   bool                               `json:"disabled"`
+}
+
+type eventOrchestrationPathCondition struct {
+	Expression string `json:"expression,omitempty"`
+}
+
+type eventOrchestrationPathCatchAll struct {
+	Actions *eventOrchestrationPathActions `json:"actions"`
+}
+
+// eventOrchestrationPathActions are the actions of all the types of paths,
+// each type supporting some of them.
+type eventOrchestrationPathActions struct {
+	DropEvent                  bool                                               `json:"drop_event"`
+	RouteTo                    string                                             `json:"route_to"`
+	DynamicRouteTo             *eventOrchestrationPathDynamicRouteTo              `json:"dynamic_route_to"`
+	Suppress                   bool                                               `json:"suppress"`
+	Suspend                    *int                                               `json:"suspend"`
+	Priority                   string                                             `json:"priority"`
+	Annotate                   string                                             `json:"annotate"`
+	PagerdutyAutomationActions []*eventOrchestrationPathPagerdutyAutomationAction `json:"pagerduty_automation_actions"`
+	AutomationActions          []*eventOrchestrationPathAutomationAction          `json:"automation_actions"`
+	IncidentCustomFieldUpdates []*eventOrchestrationPathIncidentCustomFieldUpdate `json:"incident_custom_field_updates"`
+	Severity                   string                                             `json:"severity"`
+	EventAction                string                                             `json:"event_action"`
+	Variables                  []*eventOrchestrationPathVariable                  `json:"variables"`
+	Extractions                []*eventOrchestrationPathExtraction                `json:"extractions"`
+	EscalationPolicy           *string                                            `json:"escalation_policy"`
+}
+
+type eventOrchestrationPathDynamicRouteTo struct {
+	Source   string `json:"source,omitempty"`
+	Regex    string `json:"regex,omitempty"`
+	LookupBy string `json:"lookup_by,omitempty"`
+}
+
+type eventOrchestrationPathIncidentCustomFieldUpdate struct {
+	ID    string `json:"id,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type eventOrchestrationPathPagerdutyAutomationAction struct {
+	ActionID     string   `json:"action_id,omitempty"`
+	TriggerTypes []string `json:"trigger_types,omitempty"`
+}
+
+type eventOrchestrationPathAutomationAction struct {
+	Name         string                                    `json:"name,omitempty"`
+	URL          string                                    `json:"url,omitempty"`
+	AutoSend     bool                                      `json:"auto_send,omitempty"`
+	Headers      []*eventOrchestrationPathAutomationObject `json:"headers"`
+	Parameters   []*eventOrchestrationPathAutomationObject `json:"parameters"`
+	TriggerTypes []string                                  `json:"trigger_types,omitempty"`
+}
+
+type eventOrchestrationPathAutomationObject struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type eventOrchestrationPathVariable struct {
+	Name  string `json:"name,omitempty"`
+	Path  string `json:"path,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type eventOrchestrationPathExtraction struct {
+	Target   string `json:"target,omitempty"`
+	Regex    string `json:"regex,omitempty"`
+	Template string `json:"template,omitempty"`
+	Source   string `json:"source,omitempty"`
+}
+
+// eventOrchestrationPathSetModel is a set of the path resources, whose rules
+// have actions of type A, which depends on the type of the path.
+type eventOrchestrationPathSetModel[A any] struct {
+	ID    types.String                         `tfsdk:"id"`
+	Rules []eventOrchestrationPathRuleModel[A] `tfsdk:"rule"`
+}
+
+type eventOrchestrationPathRuleModel[A any] struct {
+	ID         types.String                           `tfsdk:"id"`
+	Key        types.String                           `tfsdk:"key"`
+	Label      types.String                           `tfsdk:"label"`
+	Conditions []eventOrchestrationPathConditionModel `tfsdk:"condition"`
+	Actions    []A                                    `tfsdk:"actions"`
+	Disabled   types.Bool                             `tfsdk:"disabled"`
+}
+
+// eventOrchestrationPathCatchAllModel is the catch-all of the path
+// resources, with actions of type C.
+type eventOrchestrationPathCatchAllModel[C any] struct {
+	Actions []C `tfsdk:"actions"`
+}
+
+// eventOrchestrationPathCatchAllActions returns the actions of the catch-all
+// of a path, or nil when not set.
+func eventOrchestrationPathCatchAllActions[C any](catchAll []eventOrchestrationPathCatchAllModel[C]) *C {
+	if c := eventOrchestrationPathBlock(catchAll); c != nil {
+		return eventOrchestrationPathBlock(c.Actions)
+	}
+	return nil
+}
+
+// newEventOrchestrationPathCatchAll returns the catch-all block of a path
+// with actions.
+func newEventOrchestrationPathCatchAll[C any](actions *C) []eventOrchestrationPathCatchAllModel[C] {
+	return []eventOrchestrationPathCatchAllModel[C]{{Actions: eventOrchestrationPathBlockItems(actions)}}
+}
+
+type eventOrchestrationPathConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+}
+
+type eventOrchestrationPathVariableModel struct {
+	Name  types.String `tfsdk:"name"`
+	Path  types.String `tfsdk:"path"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type eventOrchestrationPathExtractionModel struct {
+	Regex    types.String `tfsdk:"regex"`
+	Source   types.String `tfsdk:"source"`
+	Target   types.String `tfsdk:"target"`
+	Template types.String `tfsdk:"template"`
+}
+
+type eventOrchestrationPathIncidentCustomFieldUpdateModel struct {
+	ID    types.String `tfsdk:"id"`
+	Value types.String `tfsdk:"value"`
+}
+
+type eventOrchestrationPathAutomationActionModel struct {
+	Name         types.String                                  `tfsdk:"name"`
+	URL          types.String                                  `tfsdk:"url"`
+	AutoSend     types.Bool                                    `tfsdk:"auto_send"`
+	Headers      []eventOrchestrationPathAutomationObjectModel `tfsdk:"header"`
+	Parameters   []eventOrchestrationPathAutomationObjectModel `tfsdk:"parameter"`
+	TriggerTypes types.List                                    `tfsdk:"trigger_types"`
+}
+
+type eventOrchestrationPathAutomationObjectModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type eventOrchestrationPathPagerdutyAutomationActionModel struct {
+	ActionID     types.String `tfsdk:"action_id"`
+	TriggerTypes types.List   `tfsdk:"trigger_types"`
+}
+
+type eventOrchestrationPathDynamicRouteToModel struct {
+	LookupBy types.String `tfsdk:"lookup_by"`
+	Regex    types.String `tfsdk:"regex"`
+	Source   types.String `tfsdk:"source"`
+}
+
+func expandEventOrchestrationPathSets[A any](sets []eventOrchestrationPathSetModel[A], ruleIDs []string, expandActions func(*A) *eventOrchestrationPathActions) []*eventOrchestrationPathSet {
+	result := []*eventOrchestrationPathSet{}
+	i := 0
+	for _, s := range sets {
+		set := &eventOrchestrationPathSet{
+			ID:    s.ID.ValueString(),
+			Rules: []*eventOrchestrationPathRule{},
+		}
+		for _, r := range s.Rules {
+			rule := &eventOrchestrationPathRule{
+				Label:      r.Label.ValueString(),
+				Disabled:   r.Disabled.ValueBool(),
+				Conditions: expandEventOrchestrationPathConditions(r.Conditions),
+				Actions:    expandActions(eventOrchestrationPathBlock(r.Actions)),
+			}
+			if i < len(ruleIDs) {
+				rule.ID = ruleIDs[i]
+			}
+			set.Rules = append(set.Rules, rule)
+			i++
+		}
+		result = append(result, set)
+	}
+	return result
+}
+
+// flattenEventOrchestrationPathSets flattens the sets returned by the API,
+// given their prior value, in the plan or state, as the API returns empty
+// values for the attributes not set and doesn't store the `key` of rules.
+func flattenEventOrchestrationPathSets[A any](sets []*eventOrchestrationPathSet, prior []eventOrchestrationPathSetModel[A], flattenActions func(*eventOrchestrationPathActions, *A) *A) []eventOrchestrationPathSetModel[A] {
+	priorSets := map[string]eventOrchestrationPathSetModel[A]{}
+	for _, s := range prior {
+		priorSets[s.ID.ValueString()] = s
+	}
+
+	result := []eventOrchestrationPathSetModel[A]{}
+	for _, set := range sets {
+		model := eventOrchestrationPathSetModel[A]{
+			ID:    types.StringValue(set.ID),
+			Rules: []eventOrchestrationPathRuleModel[A]{},
+		}
+		for i, rule := range set.Rules {
+			p := priorEventOrchestrationPathRule(priorSets[set.ID].Rules, i, rule.ID)
+			model.Rules = append(model.Rules, eventOrchestrationPathRuleModel[A]{
+				ID:         types.StringValue(rule.ID),
+				Key:        p.Key,
+				Label:      eventOrchestrationPathString(rule.Label, p.Label),
+				Conditions: flattenEventOrchestrationPathConditions(rule.Conditions),
+				Actions:    eventOrchestrationPathBlockItems(flattenActions(rule.Actions, eventOrchestrationPathBlock(p.Actions))),
+				Disabled:   eventOrchestrationPathBool(rule.Disabled, p.Disabled),
+			})
+		}
+		result = append(result, model)
+	}
+	return result
+}
+
+// priorEventOrchestrationPathRule finds the prior value of a rule returned
+// by the API by ID or, for rules just created, by position. Rules not found
+// have a zero value, with all attributes null.
+func priorEventOrchestrationPathRule[A any](prior []eventOrchestrationPathRuleModel[A], i int, id string) eventOrchestrationPathRuleModel[A] {
+	for _, r := range prior {
+		if r.ID.ValueString() == id {
+			return r
+		}
+	}
+	if i < len(prior) && prior[i].ID.ValueString() == "" {
+		return prior[i]
+	}
+	return eventOrchestrationPathRuleModel[A]{}
+}
+
+func expandEventOrchestrationPathConditions(conditions []eventOrchestrationPathConditionModel) []*eventOrchestrationPathCondition {
+	result := []*eventOrchestrationPathCondition{}
+	for _, c := range conditions {
+		result = append(result, &eventOrchestrationPathCondition{Expression: c.Expression.ValueString()})
+	}
+	return result
+}
+
+func flattenEventOrchestrationPathConditions(conditions []*eventOrchestrationPathCondition) []eventOrchestrationPathConditionModel {
+	result := []eventOrchestrationPathConditionModel{}
+	for _, c := range conditions {
+		result = append(result, eventOrchestrationPathConditionModel{Expression: types.StringValue(c.Expression)})
+	}
+	return result
+}
+
+func expandEventOrchestrationPathVariables(variables []eventOrchestrationPathVariableModel) []*eventOrchestrationPathVariable {
+	result := []*eventOrchestrationPathVariable{}
+	for _, v := range variables {
+		result = append(result, &eventOrchestrationPathVariable{
+			Name:  v.Name.ValueString(),
+			Path:  v.Path.ValueString(),
+			Type:  v.Type.ValueString(),
+			Value: v.Value.ValueString(),
+		})
+	}
+	return result
+}
+
+func flattenEventOrchestrationPathVariables(variables []*eventOrchestrationPathVariable) []eventOrchestrationPathVariableModel {
+	result := []eventOrchestrationPathVariableModel{}
+	for _, v := range variables {
+		result = append(result, eventOrchestrationPathVariableModel{
+			Name:  types.StringValue(v.Name),
+			Path:  types.StringValue(v.Path),
+			Type:  types.StringValue(v.Type),
+			Value: types.StringValue(v.Value),
+		})
+	}
+	return result
+}
+
+func expandEventOrchestrationPathExtractions(extractions []eventOrchestrationPathExtractionModel) []*eventOrchestrationPathExtraction {
+	result := []*eventOrchestrationPathExtraction{}
+	for _, e := range extractions {
+		result = append(result, &eventOrchestrationPathExtraction{
+			Target:   e.Target.ValueString(),
+			Regex:    e.Regex.ValueString(),
+			Template: e.Template.ValueString(),
+			Source:   e.Source.ValueString(),
+		})
+	}
+	return result
+}
+
+func flattenEventOrchestrationPathExtractions(extractions []*eventOrchestrationPathExtraction, prior []eventOrchestrationPathExtractionModel) []eventOrchestrationPathExtractionModel {
+	result := []eventOrchestrationPathExtractionModel{}
+	for i, e := range extractions {
+		var p eventOrchestrationPathExtractionModel
+		if i < len(prior) {
+			p = prior[i]
+		}
+		result = append(result, eventOrchestrationPathExtractionModel{
+			Target:   types.StringValue(e.Target),
+			Regex:    eventOrchestrationPathString(e.Regex, p.Regex),
+			Template: eventOrchestrationPathString(e.Template, p.Template),
+			Source:   eventOrchestrationPathString(e.Source, p.Source),
+		})
+	}
+	return result
+}
+
+func expandEventOrchestrationPathIncidentCustomFieldUpdates(updates []eventOrchestrationPathIncidentCustomFieldUpdateModel) []*eventOrchestrationPathIncidentCustomFieldUpdate {
+	result := []*eventOrchestrationPathIncidentCustomFieldUpdate{}
+	for _, u := range updates {
+		result = append(result, &eventOrchestrationPathIncidentCustomFieldUpdate{
+			ID:    u.ID.ValueString(),
+			Value: u.Value.ValueString(),
+		})
+	}
+	return result
+}
+
+func flattenEventOrchestrationPathIncidentCustomFieldUpdates(updates []*eventOrchestrationPathIncidentCustomFieldUpdate) []eventOrchestrationPathIncidentCustomFieldUpdateModel {
+	result := []eventOrchestrationPathIncidentCustomFieldUpdateModel{}
+	for _, u := range updates {
+		result = append(result, eventOrchestrationPathIncidentCustomFieldUpdateModel{
+			ID:    types.StringValue(u.ID),
+			Value: types.StringValue(u.Value),
+		})
+	}
+	return result
+}
+
+func expandEventOrchestrationPathAutomationActions(actions []eventOrchestrationPathAutomationActionModel) []*eventOrchestrationPathAutomationAction {
+	result := []*eventOrchestrationPathAutomationAction{}
+	for _, a := range actions {
+		result = append(result, &eventOrchestrationPathAutomationAction{
+			Name:         a.Name.ValueString(),
+			URL:          a.URL.ValueString(),
+			AutoSend:     a.AutoSend.ValueBool(),
+			Headers:      expandEventOrchestrationPathAutomationObjects(a.Headers),
+			Parameters:   expandEventOrchestrationPathAutomationObjects(a.Parameters),
+			TriggerTypes: expandEventOrchestrationPathTriggerTypes(a.TriggerTypes),
+		})
+	}
+	return result
+}
+
+func flattenEventOrchestrationPathAutomationActions(actions []*eventOrchestrationPathAutomationAction, prior []eventOrchestrationPathAutomationActionModel) []eventOrchestrationPathAutomationActionModel {
+	result := []eventOrchestrationPathAutomationActionModel{}
+	for i, a := range actions {
+		var p eventOrchestrationPathAutomationActionModel
+		if i < len(prior) {
+			p = prior[i]
+		}
+		result = append(result, eventOrchestrationPathAutomationActionModel{
+			Name:         types.StringValue(a.Name),
+			URL:          types.StringValue(a.URL),
+			AutoSend:     types.BoolValue(a.AutoSend),
+			Headers:      flattenEventOrchestrationPathAutomationObjects(a.Headers),
+			Parameters:   flattenEventOrchestrationPathAutomationObjects(a.Parameters),
+			TriggerTypes: flattenEventOrchestrationPathTriggerTypes(a.TriggerTypes, p.TriggerTypes),
+		})
+	}
+	return result
+}
+
+func expandEventOrchestrationPathAutomationObjects(objects []eventOrchestrationPathAutomationObjectModel) []*eventOrchestrationPathAutomationObject {
+	result := []*eventOrchestrationPathAutomationObject{}
+	for _, o := range objects {
+		result = append(result, &eventOrchestrationPathAutomationObject{
+			Key:   o.Key.ValueString(),
+			Value: o.Value.ValueString(),
+		})
+	}
+	return result
+}
+
+func flattenEventOrchestrationPathAutomationObjects(objects []*eventOrchestrationPathAutomationObject) []eventOrchestrationPathAutomationObjectModel {
+	result := []eventOrchestrationPathAutomationObjectModel{}
+	for _, o := range objects {
+		result = append(result, eventOrchestrationPathAutomationObjectModel{
+			Key:   types.StringValue(o.Key),
+			Value: types.StringValue(o.Value),
+		})
+	}
+	return result
+}
+
+func expandEventOrchestrationPathPagerdutyAutomationActions(actions []eventOrchestrationPathPagerdutyAutomationActionModel) []*eventOrchestrationPathPagerdutyAutomationAction {
+	result := []*eventOrchestrationPathPagerdutyAutomationAction{}
+	for _, a := range actions {
+		result = append(result, &eventOrchestrationPathPagerdutyAutomationAction{
+			ActionID:     a.ActionID.ValueString(),
+			TriggerTypes: expandEventOrchestrationPathTriggerTypes(a.TriggerTypes),
+		})
+	}
+	return result
+}
+
+func flattenEventOrchestrationPathPagerdutyAutomationActions(actions []*eventOrchestrationPathPagerdutyAutomationAction, prior []eventOrchestrationPathPagerdutyAutomationActionModel) []eventOrchestrationPathPagerdutyAutomationActionModel {
+	result := []eventOrchestrationPathPagerdutyAutomationActionModel{}
+	for i, a := range actions {
+		var p eventOrchestrationPathPagerdutyAutomationActionModel
+		if i < len(prior) {
+			p = prior[i]
+		}
+		result = append(result, eventOrchestrationPathPagerdutyAutomationActionModel{
+			ActionID:     types.StringValue(a.ActionID),
+			TriggerTypes: flattenEventOrchestrationPathTriggerTypes(a.TriggerTypes, p.TriggerTypes),
+		})
+	}
+	return result
+}
+
+func expandEventOrchestrationPathTriggerTypes(v types.List) []string {
+	var result []string
+	for _, e := range v.Elements() {
+		if s, ok := e.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			result = append(result, s.ValueString())
+		}
+	}
+	return result
+}
+
+// flattenEventOrchestrationPathTriggerTypes returns the trigger types of an
+// automation action, which the API defaults when not set.
+func flattenEventOrchestrationPathTriggerTypes(v []string, prior types.List) types.List {
+	if len(v) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ListNull(types.StringType)
+	}
+	elements := []attr.Value{}
+	for _, s := range v {
+		elements = append(elements, types.StringValue(s))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func expandEventOrchestrationPathDynamicRouteTo(items []eventOrchestrationPathDynamicRouteToModel) *eventOrchestrationPathDynamicRouteTo {
+	v := eventOrchestrationPathBlock(items)
+	if v == nil {
+		return nil
+	}
+	return &eventOrchestrationPathDynamicRouteTo{
+		LookupBy: v.LookupBy.ValueString(),
+		Regex:    v.Regex.ValueString(),
+		Source:   v.Source.ValueString(),
+	}
+}
+
+func flattenEventOrchestrationPathDynamicRouteTo(v *eventOrchestrationPathDynamicRouteTo) []eventOrchestrationPathDynamicRouteToModel {
+	if v == nil {
+		return []eventOrchestrationPathDynamicRouteToModel{}
+	}
+	return []eventOrchestrationPathDynamicRouteToModel{{
+		LookupBy: types.StringValue(v.LookupBy),
+		Regex:    types.StringValue(v.Regex),
+		Source:   types.StringValue(v.Source),
+	}}
+}
+
+// eventOrchestrationPathBlock returns the item of a block of at most one
+// item, or nil when the block isn't set.
+func eventOrchestrationPathBlock[T any](items []T) *T {
+	if len(items) == 0 {
+		return nil
+	}
+	return &items[0]
+}
+
+// eventOrchestrationPathBlockItems returns the items of a block of at most
+// one item holding v, when not nil.
+func eventOrchestrationPathBlockItems[T any](v *T) []T {
+	if v == nil {
+		return []T{}
+	}
+	return []T{*v}
+}
+
+// eventOrchestrationPathString returns the value of an optional attribute
+// read from the API, which returns an empty value for the attributes not set:
+// the attribute is null, unless set to the empty value in prior.
+func eventOrchestrationPathString(v string, prior types.String) types.String {
+	if v == "" && (prior.IsNull() || prior.IsUnknown() || prior.ValueString() != "") {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func eventOrchestrationPathStringPtr(v *string, prior types.String) types.String {
+	if v == nil {
+		return eventOrchestrationPathString("", prior)
+	}
+	return eventOrchestrationPathString(*v, prior)
+}
+
+func eventOrchestrationPathBool(v bool, prior types.Bool) types.Bool {
+	if !v && (prior.IsNull() || prior.IsUnknown() || prior.ValueBool()) {
+		return types.BoolNull()
+	}
+	return types.BoolValue(v)
+}
+
+func eventOrchestrationPathInt64(v *int, prior types.Int64) types.Int64 {
+	if (v == nil || *v == 0) && (prior.IsNull() || prior.IsUnknown() || prior.ValueInt64() != 0) {
+		return types.Int64Null()
+	}
+	if v == nil {
+		return types.Int64Value(0)
+	}
+	return types.Int64Value(int64(*v))
+}
+
+type eventOrchestrationPathRuleIdentity struct {
+	id    string
+	key   string
+	label string
+}
+
+// eventOrchestrationPathRuleIdentities lists the rules of all the sets of a
+// path, in order. It reports false when a key or label isn't known yet.
+func eventOrchestrationPathRuleIdentities[A any](sets []eventOrchestrationPathSetModel[A]) ([]eventOrchestrationPathRuleIdentity, bool) {
+	var identities []eventOrchestrationPathRuleIdentity
+	known := true
+	for _, s := range sets {
+		for _, r := range s.Rules {
+			if r.Key.IsUnknown() || r.Label.IsUnknown() {
+				known = false
+			}
+			identities = append(identities, eventOrchestrationPathRuleIdentity{
+				id:    r.ID.ValueString(),
+				key:   r.Key.ValueString(),
+				label: r.Label.ValueString(),
+			})
+		}
+	}
+	return identities, known
+}
+
+// planEventOrchestrationPathRuleIDs plans the IDs of the rules of a path,
+// which keep the ID they have in state, so inserting or moving a rule doesn't
+// change the IDs of the others. The IDs of new rules, or of all the rules
+// when their keys or labels aren't known yet, are unknown.
+func planEventOrchestrationPathRuleIDs[A any](state, plan []eventOrchestrationPathSetModel[A]) {
+	old, _ := eventOrchestrationPathRuleIdentities(state)
+	new, known := eventOrchestrationPathRuleIdentities(plan)
+	ids := matchEventOrchestrationPathRuleIDs(old, new)
+
+	i := 0
+	for si := range plan {
+		for ri := range plan[si].Rules {
+			plan[si].Rules[ri].ID = types.StringUnknown()
+			if known && ids[i] != "" {
+				plan[si].Rules[ri].ID = types.StringValue(ids[i])
+			}
+			i++
+		}
+	}
+}
+
+// eventOrchestrationPathRuleIDs returns the IDs the rules of plan are sent to
+// the API with, in order, matched with the rules in state.
+func eventOrchestrationPathRuleIDs[A any](state, plan []eventOrchestrationPathSetModel[A]) []string {
+	old, _ := eventOrchestrationPathRuleIdentities(state)
+	new, _ := eventOrchestrationPathRuleIdentities(plan)
+	return matchEventOrchestrationPathRuleIDs(old, new)
+}
+
+// matchEventOrchestrationPathRuleIDs matches the rules of a path with the
+// rules it had, returning their IDs, or an empty ID for new rules. Rules are
+// matched by `key`, then by `label` when it is unique, and the other rules in
+// order.
+func matchEventOrchestrationPathRuleIDs(old, new []eventOrchestrationPathRuleIdentity) []string {
+	ids := make([]string, len(new))
+
+	byKey := map[string]string{}
+	byLabel := map[string]string{}
+	oldLabels := map[string]int{}
+	for _, r := range old {
+		if r.id == "" {
+			continue
+		}
+		if r.key != "" {
+			byKey[r.key] = r.id
+		}
+		if r.label != "" {
+			if r.key == "" {
+				byLabel[r.label] = r.id
+			}
+			oldLabels[r.label]++
+		}
+	}
+	newLabels := map[string]int{}
+	for _, r := range new {
+		if r.label != "" {
+			newLabels[r.label]++
+		}
+	}
+
+	claimed := map[string]bool{}
+	matched := make([]bool, len(new))
+	for i, r := range new {
+		id, ok := byKey[r.key]
+		switch {
+		case r.key != "" && ok:
+		case r.label != "" && oldLabels[r.label] == 0:
+			// A new label is a new rule.
+		case r.label != "" && oldLabels[r.label] == 1 && newLabels[r.label] == 1:
+			// Also matches the rules a key was just given to.
+			id, ok = byLabel[r.label]
+			if !ok {
+				continue
+			}
+		default:
+			continue
+		}
+		if claimed[id] {
+			id = ""
+		}
+		if id != "" {
+			claimed[id] = true
+		}
+		ids[i] = id
+		matched[i] = true
+	}
+
+	// The other rules are matched in order with the rules left with the same
+	// label, if any, and no key.
+	left := map[string][]string{}
+	for _, r := range old {
+		if r.id != "" && r.key == "" && !claimed[r.id] {
+			left[r.label] = append(left[r.label], r.id)
+		}
+	}
+	for i, r := range new {
+		if matched[i] {
+			continue
+		}
+		if l := left[r.label]; len(l) > 0 {
+			ids[i] = l[0]
+			left[r.label] = l[1:]
+		}
+	}
+	return ids
+}
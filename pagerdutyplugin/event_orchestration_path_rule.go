@@ -0,0 +1,331 @@
+package pagerduty
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// eventOrchestrationPathRulePositionAttributes are the attributes placing a
+// rule managed on its own within the set of an orchestration path.
+func eventOrchestrationPathRulePositionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"priority": schema.Int64Attribute{
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+				int64validator.ConflictsWith(path.MatchRoot("insert_before"), path.MatchRoot("insert_after")),
+			},
+		},
+		"insert_before": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("priority"), path.MatchRoot("insert_after")),
+			},
+		},
+		"insert_after": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("priority"), path.MatchRoot("insert_before")),
+			},
+		},
+		"index": schema.Int64Attribute{Computed: true},
+	}
+}
+
+// eventOrchestrationPathRulePosition is where a rule is inserted in a set:
+// at `priority` when set, otherwise next to the `anchor` rule, otherwise at
+// the end of the set.
+type eventOrchestrationPathRulePosition struct {
+	priority types.Int64
+	anchor   string
+	after    bool
+}
+
+func newEventOrchestrationPathRulePosition(priority types.Int64, insertBefore, insertAfter types.String) eventOrchestrationPathRulePosition {
+	p := eventOrchestrationPathRulePosition{priority: priority}
+	if v := insertBefore.ValueString(); v != "" {
+		p.anchor = v
+	}
+	if v := insertAfter.ValueString(); v != "" {
+		p.anchor = v
+		p.after = true
+	}
+	return p
+}
+
+func (p eventOrchestrationPathRulePosition) equal(o eventOrchestrationPathRulePosition) bool {
+	return p.priority.Equal(o.priority) && p.anchor == o.anchor && p.after == o.after
+}
+
+var eventOrchestrationPathLocks sync.Map
+
+// lockEventOrchestrationPath serializes the read-modify-write cycles of the
+// resources sharing the same orchestration path within this provider.
+func lockEventOrchestrationPath(pathType, parent string) func() {
+	mu, _ := eventOrchestrationPathLocks.LoadOrStore(pathType+":"+parent, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+var (
+	// errEventOrchestrationPathNotFound is returned when the parent of the
+	// path is gone.
+	errEventOrchestrationPathNotFound = errors.New("Event Orchestration Path not found")
+	// errEventOrchestrationPathRuleNotFound is returned by the modifications
+	// of a path removing a rule which was already removed.
+	errEventOrchestrationPathRuleNotFound = errors.New("Event Orchestration Path rule not found")
+)
+
+// eventOrchestrationPathConflictError is returned when another client changed
+// a path while a rule was being written to it.
+type eventOrchestrationPathConflictError struct {
+	pathType, parent, rule string
+}
+
+func (e *eventOrchestrationPathConflictError) Error() string {
+	return fmt.Sprintf("Event Orchestration Path of type %s for %s was changed by another client while rule %s was being written to it. The rule wasn't written: plan and apply again to review the changes", e.pathType, e.parent, e.rule)
+}
+
+// eventOrchestrationPathRuleName names a rule in errors, by ID or, for rules
+// being created, by label.
+func eventOrchestrationPathRuleName(ruleID string, label types.String) string {
+	if ruleID != "" {
+		return ruleID
+	}
+	return fmt.Sprintf("%q", label.ValueString())
+}
+
+// eventOrchestrationPathRuleChecksum identifies the content of a rule, so a
+// rule changed outside of Terraform since it was last read can be detected.
+func eventOrchestrationPathRuleChecksum(rule map[string]interface{}) string {
+	b, _ := json.Marshal(rule)
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+// checkEventOrchestrationPathRuleConflict fails when the rule with the given
+// checksum in state no longer matches the rule in the orchestration path.
+func checkEventOrchestrationPathRuleConflict(rule map[string]interface{}, checksum types.String) error {
+	if checksum.ValueString() == "" || eventOrchestrationPathRuleChecksum(rule) == checksum.ValueString() {
+		return nil
+	}
+	return fmt.Errorf("Event Orchestration Path rule %s was changed outside of Terraform since it was last read. Refresh the state and plan again to review the changes before applying them", rule["id"])
+}
+
+// eventOrchestrationPathRevision identifies the revision of a path document
+// by its version or, when the API doesn't return one, its content.
+func eventOrchestrationPathRevision(doc map[string]interface{}) string {
+	if v, _ := doc["version"].(string); v != "" {
+		return v
+	}
+	if v, _ := doc["updated_at"].(string); v != "" {
+		return v
+	}
+	b, _ := json.Marshal(doc)
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+// getEventOrchestrationPathDocument reads the document of a path, returning
+// nil when its parent is gone.
+func getEventOrchestrationPathDocument(ctx context.Context, client *pagerduty.Client, apiURL, pathType, parent string) (map[string]interface{}, error) {
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+	var doc map[string]interface{}
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		d, _, err := eventOrchestrationPathDocumentRequest(ctx, client, apiURL, http.MethodGet, pathType, parent, nil)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			// The API answers 403 instead of 404 for the path of a deleted service.
+			if util.IsNotFoundError(err) || (pathType == "service" && isForbiddenError(err)) {
+				return nil
+			}
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		doc = d
+		return nil
+	})
+	return doc, err
+}
+
+// modifyEventOrchestrationPath reads the document of a path, lets `modify`
+// change it and writes it back, so the rules owned by other configurations,
+// and the fields not modeled by this provider, are kept as they are.
+//
+// The path is read again right before being written: when another client
+// changed it in the meantime, the write is refused with an error naming the
+// path and `rule`, rather than overwriting their change. The API has no
+// conditional update, so a change landing between this check and the write
+// can't be detected.
+func modifyEventOrchestrationPath(ctx context.Context, client *pagerduty.Client, apiURL, pathType, parent, rule string, modify func(doc map[string]interface{}) error) (map[string]interface{}, []string, error) {
+	defer lockEventOrchestrationPath(pathType, parent)()
+
+	doc, err := getEventOrchestrationPathDocument(ctx, client, apiURL, pathType, parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if doc == nil {
+		return nil, nil, errEventOrchestrationPathNotFound
+	}
+	revision := eventOrchestrationPathRevision(doc)
+
+	if err := modify(doc); err != nil {
+		return nil, nil, err
+	}
+
+	current, err := getEventOrchestrationPathDocument(ctx, client, apiURL, pathType, parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if current == nil {
+		return nil, nil, errEventOrchestrationPathNotFound
+	}
+	if eventOrchestrationPathRevision(current) != revision {
+		return nil, nil, &eventOrchestrationPathConflictError{pathType: pathType, parent: parent, rule: rule}
+	}
+
+	for _, k := range eventOrchestrationPathServerFields {
+		delete(doc, k)
+	}
+
+	log.Printf("[INFO] Updating PagerDuty Event Orchestration Path of type %s for %s", pathType, parent)
+
+	var result map[string]interface{}
+	var warnings []string
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		d, w, err := eventOrchestrationPathDocumentRequest(ctx, client, apiURL, http.MethodPut, pathType, parent, doc)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		result, warnings = d, w
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, warnings, nil
+}
+
+func addEventOrchestrationPathWarnings(warnings []string, diags *diag.Diagnostics) {
+	for _, w := range warnings {
+		diags.AddWarning("Event Orchestration Path warning", w)
+	}
+}
+
+// findEventOrchestrationPathSet returns the set of a path document with the
+// given ID, or nil.
+func findEventOrchestrationPathSet(doc map[string]interface{}, setID string) map[string]interface{} {
+	for _, set := range eventOrchestrationPathDocumentList(doc, "sets") {
+		if id, _ := set["id"].(string); id == setID {
+			return set
+		}
+	}
+	return nil
+}
+
+// findEventOrchestrationPathRule returns the set holding the rule with the
+// given ID and the index of the rule within it.
+func findEventOrchestrationPathRule(doc map[string]interface{}, ruleID string) (map[string]interface{}, int) {
+	for _, set := range eventOrchestrationPathDocumentList(doc, "sets") {
+		rules, _ := set["rules"].([]interface{})
+		for i, r := range rules {
+			if rule, ok := r.(map[string]interface{}); ok && rule["id"] == ruleID {
+				return set, i
+			}
+		}
+	}
+	return nil, -1
+}
+
+// eventOrchestrationPathSetRule returns the rule at index of a set, or nil.
+func eventOrchestrationPathSetRule(set map[string]interface{}, index int) map[string]interface{} {
+	rules, _ := set["rules"].([]interface{})
+	if index < 0 || index >= len(rules) {
+		return nil
+	}
+	rule, _ := rules[index].(map[string]interface{})
+	return rule
+}
+
+// insertEventOrchestrationPathRule inserts `rule` in the rules of `set` at
+// `position` and returns the index the rule was inserted at.
+func insertEventOrchestrationPathRule(set, rule map[string]interface{}, position eventOrchestrationPathRulePosition) (int, error) {
+	rules, _ := set["rules"].([]interface{})
+	index := len(rules)
+	switch {
+	case !position.priority.IsNull() && !position.priority.IsUnknown():
+		if p := int(position.priority.ValueInt64()); p < index {
+			index = p
+		}
+	case position.anchor != "":
+		index = -1
+		for i, r := range rules {
+			if r, ok := r.(map[string]interface{}); ok && r["id"] == position.anchor {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return -1, fmt.Errorf("Rule %q to insert the rule next to doesn't exist", position.anchor)
+		}
+		if position.after {
+			index++
+		}
+	}
+
+	rules = append(rules, nil)
+	copy(rules[index+1:], rules[index:])
+	rules[index] = rule
+	set["rules"] = rules
+	return index, nil
+}
+
+func removeEventOrchestrationPathRule(set map[string]interface{}, index int) {
+	rules, _ := set["rules"].([]interface{})
+	set["rules"] = append(rules[:index], rules[index+1:]...)
+}
+
+// eventOrchestrationPathRuleDocument converts a rule to the document of a
+// rule of a path.
+func eventOrchestrationPathRuleDocument(rule *eventOrchestrationPathRule) map[string]interface{} {
+	b, _ := json.Marshal(rule)
+	var doc map[string]interface{}
+	_ = json.Unmarshal(b, &doc)
+	return doc
+}
+
+// decodeEventOrchestrationPathRule converts the document of a rule of a path
+// to a rule.
+func decodeEventOrchestrationPathRule(doc map[string]interface{}) (*eventOrchestrationPathRule, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	rule := new(eventOrchestrationPathRule)
+	if err := json.Unmarshal(b, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
@@ -0,0 +1,84 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// eventOrchestrationPathStateUpgraders upgrade the state of the path
+// resources, as written by their SDKv2 implementation, to s.
+//
+// Optional attributes not set had the zero value of their type, now null.
+// All the other values, including the IDs of the rules, are kept.
+func eventOrchestrationPathStateUpgraders(s schema.Schema) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					resp.Diagnostics.AddError("Error upgrading Event Orchestration Path state", "no prior state")
+					return
+				}
+
+				var state map[string]interface{}
+				dec := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+				dec.UseNumber()
+				if err := dec.Decode(&state); err != nil {
+					resp.Diagnostics.AddError("Error upgrading Event Orchestration Path state", err.Error())
+					return
+				}
+
+				b, err := json.Marshal(upgradeEventOrchestrationPathStateV0(s.Attributes, s.Blocks, state))
+				if err != nil {
+					resp.Diagnostics.AddError("Error upgrading Event Orchestration Path state", err.Error())
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: b}
+			},
+		},
+	}
+}
+
+func upgradeEventOrchestrationPathStateV0(attributes map[string]schema.Attribute, blocks map[string]schema.Block, v map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for name, a := range attributes {
+		value := v[name]
+		if a.IsOptional() && !a.IsComputed() && isZeroStateValueV0(value) {
+			value = nil
+		}
+		result[name] = value
+	}
+
+	for name, b := range blocks {
+		items, _ := v[name].([]interface{})
+		if b, ok := b.(schema.ListNestedBlock); ok {
+			list := []interface{}{}
+			for _, item := range items {
+				obj, _ := item.(map[string]interface{})
+				list = append(list, upgradeEventOrchestrationPathStateV0(b.NestedObject.Attributes, b.NestedObject.Blocks, obj))
+			}
+			result[name] = list
+		}
+	}
+	return result
+}
+
+func isZeroStateValueV0(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		return v.String() == "0"
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,215 @@
+package pagerduty
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourcePagerDutyEventOrchestrationPathUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol5WithError(New())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range schemaResp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	rule := func(i int) *tftypes.AttributePath {
+		return tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyInt(0).WithAttributeName("rule").WithElementKeyInt(i)
+	}
+	catchAll := tftypes.NewAttributePath().WithAttributeName("catch_all").WithElementKeyInt(0).WithAttributeName("actions").WithElementKeyInt(0)
+
+	cases := []struct {
+		typeName string
+		// State as written by the SDKv2 implementation of the resource.
+		rawState string
+		expected map[*tftypes.AttributePath]interface{}
+	}{
+		{
+			typeName: "pagerduty_event_orchestration_router",
+			rawState: `{
+				"id": "PORCHES",
+				"event_orchestration": "PORCHES",
+				"set": [{"id": "start", "rule": [
+					{"id": "R1", "key": "", "label": "static", "disabled": false, "condition": [{"expression": "event.summary matches part 'db'"}], "actions": [{"route_to": "PSERVIC", "dynamic_route_to": []}]},
+					{"id": "R2", "key": "", "label": "", "disabled": true, "condition": [], "actions": [{"route_to": "", "dynamic_route_to": [{"lookup_by": "service_id", "regex": "(.*)", "source": "event.custom_details.pd_service_id"}]}]}
+				]}],
+				"catch_all": [{"actions": [{"route_to": "unrouted"}]}]
+			}`,
+			expected: map[*tftypes.AttributePath]interface{}{
+				rule(0).WithAttributeName("id"):       "R1",
+				rule(0).WithAttributeName("key"):      nil,
+				rule(0).WithAttributeName("label"):    "static",
+				rule(0).WithAttributeName("disabled"): nil,
+				rule(0).WithAttributeName("condition").WithElementKeyInt(0).WithAttributeName("expression"):     "event.summary matches part 'db'",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("route_to"):         "PSERVIC",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("dynamic_route_to"): 0,
+				rule(1).WithAttributeName("id"):       "R2",
+				rule(1).WithAttributeName("label"):    nil,
+				rule(1).WithAttributeName("disabled"): true,
+				rule(1).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("route_to"):                                                             nil,
+				rule(1).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("dynamic_route_to").WithElementKeyInt(0).WithAttributeName("lookup_by"): "service_id",
+				catchAll.WithAttributeName("route_to"): "unrouted",
+			},
+		},
+		{
+			typeName: "pagerduty_event_orchestration_service",
+			rawState: `{
+				"id": "PSERVIC",
+				"service": "PSERVIC",
+				"enable_event_orchestration_for_service": true,
+				"set": [{"id": "start", "rule": [
+					{"id": "R1", "label": "rule", "disabled": false, "condition": [], "actions": [{
+						"route_to": "", "suppress": false, "suspend": 0, "priority": "", "annotate": "note",
+						"pagerduty_automation_action": [{"action_id": "PAUTOMA", "trigger_types": ["alert_triggered"]}],
+						"automation_action": [],
+						"severity": "critical", "event_action": "", "escalation_policy": "",
+						"variable": [{"name": "host", "path": "event.summary", "type": "regex", "value": "on (.*)"}],
+						"extraction": [{"regex": "", "source": "", "target": "event.summary", "template": "{{variables.host}}"}],
+						"incident_custom_field_update": []
+					}]}
+				]}],
+				"catch_all": [{"actions": [{
+					"route_to": "", "suppress": true, "suspend": 300, "priority": "", "annotate": "",
+					"pagerduty_automation_action": [], "automation_action": [],
+					"severity": "", "event_action": "", "escalation_policy": "PESCALA",
+					"variable": [], "extraction": [], "incident_custom_field_update": []
+				}]}]
+			}`,
+			expected: map[*tftypes.AttributePath]interface{}{
+				tftypes.NewAttributePath().WithAttributeName("enable_event_orchestration_for_service"): true,
+				rule(0).WithAttributeName("id"): "R1",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("suppress"):                                                                        nil,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("suspend"):                                                                         nil,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("annotate"):                                                                        "note",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("escalation_policy"):                                                               nil,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("pagerduty_automation_action").WithElementKeyInt(0).WithAttributeName("action_id"): "PAUTOMA",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("automation_action"):                                                               0,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("variable").WithElementKeyInt(0).WithAttributeName("value"):                        "on (.*)",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("extraction").WithElementKeyInt(0).WithAttributeName("regex"):                      nil,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("extraction").WithElementKeyInt(0).WithAttributeName("template"):                   "{{variables.host}}",
+				catchAll.WithAttributeName("suppress"):          true,
+				catchAll.WithAttributeName("suspend"):           int64(300),
+				catchAll.WithAttributeName("escalation_policy"): "PESCALA",
+			},
+		},
+		{
+			typeName: "pagerduty_event_orchestration_global",
+			rawState: `{
+				"id": "PORCHES",
+				"event_orchestration": "PORCHES",
+				"set": [{"id": "start", "rule": [
+					{"id": "R1", "label": "", "disabled": false, "condition": [], "actions": [{
+						"drop_event": true, "route_to": "step-two", "suppress": false, "suspend": 0, "priority": "", "annotate": "",
+						"automation_action": [{"name": "webhook", "url": "https://example.com", "auto_send": false, "header": [{"key": "a", "value": "b"}], "parameter": [], "trigger_types": []}],
+						"severity": "", "event_action": "", "escalation_policy": "",
+						"variable": [], "extraction": [], "incident_custom_field_update": [{"id": "PFIELD", "value": "x"}]
+					}]}
+				]}, {"id": "step-two", "rule": []}],
+				"catch_all": [{"actions": [{
+					"drop_event": false, "route_to": "", "suppress": false, "suspend": 0, "priority": "", "annotate": "",
+					"automation_action": [], "severity": "", "event_action": "", "escalation_policy": "",
+					"variable": [], "extraction": [], "incident_custom_field_update": []
+				}]}]
+			}`,
+			expected: map[*tftypes.AttributePath]interface{}{
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("drop_event"):                                                                                                       true,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("route_to"):                                                                                                         "step-two",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("automation_action").WithElementKeyInt(0).WithAttributeName("auto_send"):                                            false,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("automation_action").WithElementKeyInt(0).WithAttributeName("header").WithElementKeyInt(0).WithAttributeName("key"): "a",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("incident_custom_field_update").WithElementKeyInt(0).WithAttributeName("id"):                                        "PFIELD",
+				tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyInt(1).WithAttributeName("id"):                                                                                                "step-two",
+				catchAll.WithAttributeName("drop_event"): nil,
+			},
+		},
+		{
+			typeName: "pagerduty_event_orchestration_unrouted",
+			rawState: `{
+				"id": "PORCHES",
+				"event_orchestration": "PORCHES",
+				"set": [{"id": "start", "rule": [
+					{"id": "R1", "label": "", "disabled": false, "condition": [], "actions": [{"route_to": "", "severity": "info", "event_action": "", "variable": [], "extraction": []}]}
+				]}],
+				"catch_all": [{"actions": [{"suppress": true, "severity": "", "event_action": "", "variable": [], "extraction": []}]}]
+			}`,
+			expected: map[*tftypes.AttributePath]interface{}{
+				rule(0).WithAttributeName("id"): "R1",
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("route_to"): nil,
+				rule(0).WithAttributeName("actions").WithElementKeyInt(0).WithAttributeName("severity"): "info",
+				catchAll.WithAttributeName("suppress"):                                                  true,
+				catchAll.WithAttributeName("severity"):                                                  nil,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.typeName, func(t *testing.T) {
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+				TypeName: c.typeName,
+				Version:  0,
+				RawState: &tfprotov5.RawState{JSON: []byte(c.rawState)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("%s: %s", d.Summary, d.Detail)
+			}
+
+			state, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[c.typeName].ValueType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for p, want := range c.expected {
+				v, _, err := tftypes.WalkAttributePath(state, p)
+				if err != nil {
+					t.Fatalf("%s: %s", p, err)
+				}
+				value := v.(tftypes.Value)
+
+				var got interface{}
+				switch want.(type) {
+				case string:
+					var s string
+					err = value.As(&s)
+					got = s
+				case bool:
+					var b bool
+					err = value.As(&b)
+					got = b
+				case int64:
+					var n big.Float
+					err = value.As(&n)
+					got, _ = n.Int64()
+				case int:
+					// The number of items of a block.
+					var items []tftypes.Value
+					err = value.As(&items)
+					got = len(items)
+				case nil:
+					if !value.IsNull() {
+						got = value.String()
+					}
+				}
+				if err != nil {
+					t.Fatalf("%s: %s", p, err)
+				}
+				if got != want {
+					t.Errorf("%s: expected %v, got %v", p, want, got)
+				}
+			}
+		})
+	}
+}
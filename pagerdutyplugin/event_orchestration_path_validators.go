@@ -0,0 +1,380 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// eventOrchestrationPathExtractionValidator checks that an extraction has
+// either a regex, applied to its source, or a template, and compiles its
+// regex the way PagerDuty does with RE2.
+type eventOrchestrationPathExtractionValidator struct{}
+
+var _ validator.Object = eventOrchestrationPathExtractionValidator{}
+
+func (v eventOrchestrationPathExtractionValidator) Description(_ context.Context) string {
+	return "extraction must have either a valid regex and a source, or a template"
+}
+
+func (v eventOrchestrationPathExtractionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v eventOrchestrationPathExtractionValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+	r := objectStringAttribute(attrs, "regex")
+	t := objectStringAttribute(attrs, "template")
+	s := objectStringAttribute(attrs, "source")
+
+	if !r.IsUnknown() && !t.IsUnknown() {
+		switch {
+		case r.ValueString() == "" && t.ValueString() == "":
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Event Orchestration Path extraction", "regex and template cannot both be null")
+		case r.ValueString() != "" && t.ValueString() != "":
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Event Orchestration Path extraction", "regex and template cannot both have values")
+		case r.ValueString() != "" && !s.IsUnknown() && s.ValueString() == "":
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Event Orchestration Path extraction", "source can't be blank")
+		}
+	}
+
+	if isKnownValue(r) && r.ValueString() != "" {
+		if _, err := regexp.Compile(r.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("regex"), "Invalid Event Orchestration Path extraction", err.Error())
+		}
+	}
+}
+
+// eventOrchestrationPathVariableValidator compiles the regex of a variable of
+// type regex.
+type eventOrchestrationPathVariableValidator struct{}
+
+var _ validator.Object = eventOrchestrationPathVariableValidator{}
+
+func (v eventOrchestrationPathVariableValidator) Description(_ context.Context) string {
+	return "the value of a variable of type regex must be a valid regex"
+}
+
+func (v eventOrchestrationPathVariableValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v eventOrchestrationPathVariableValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+	value := objectStringAttribute(attrs, "value")
+	if objectStringAttribute(attrs, "type").ValueString() != "regex" || !isKnownValue(value) {
+		return
+	}
+	if _, err := regexp.Compile(value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path.AtName("value"), "Invalid Event Orchestration Path variable", err.Error())
+	}
+}
+
+// eventOrchestrationPathTemplateValidator checks the templates of the
+// extractions of an `actions` block, which may only reference the variables
+// of the same block.
+type eventOrchestrationPathTemplateValidator struct{}
+
+var _ validator.Object = eventOrchestrationPathTemplateValidator{}
+
+func (v eventOrchestrationPathTemplateValidator) Description(_ context.Context) string {
+	return "templates of extractions must only reference variables of the same actions"
+}
+
+func (v eventOrchestrationPathTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v eventOrchestrationPathTemplateValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+
+	// Variables are only checked when all their names are known.
+	variables := map[string]bool{}
+	variableList, _ := attrs["variable"].(types.List)
+	if variableList.IsUnknown() {
+		variables = nil
+	}
+	for _, e := range variableList.Elements() {
+		name := objectStringAttribute(objectAttributes(e), "name")
+		if name.IsUnknown() || variables == nil {
+			variables = nil
+			continue
+		}
+		variables[name.ValueString()] = true
+	}
+
+	extractionList, _ := attrs["extraction"].(types.List)
+	for i, e := range extractionList.Elements() {
+		t := objectStringAttribute(objectAttributes(e), "template")
+		if !isKnownValue(t) || t.ValueString() == "" {
+			continue
+		}
+		if err := validateEventOrchestrationPathTemplate(t.ValueString(), variables); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("extraction").AtListIndex(i).AtName("template"), "Invalid Event Orchestration Path extraction", err.Error())
+		}
+	}
+}
+
+var eventOrchestrationPathTemplatePlaceholderRegexp = regexp.MustCompile(`\{\{([^{}]*)\}\}`)
+
+// validateEventOrchestrationPathTemplate checks that the `{{...}}`
+// placeholders of a template are closed and not empty and, unless variables
+// is nil, that `{{variables.<name>}}` placeholders name one of variables.
+func validateEventOrchestrationPathTemplate(template string, variables map[string]bool) error {
+	rest := eventOrchestrationPathTemplatePlaceholderRegexp.ReplaceAllString(template, "")
+	if strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+		return fmt.Errorf("unbalanced {{ and }} in template %q", template)
+	}
+
+	for _, m := range eventOrchestrationPathTemplatePlaceholderRegexp.FindAllStringSubmatch(template, -1) {
+		placeholder := strings.TrimSpace(m[1])
+		if placeholder == "" {
+			return fmt.Errorf("empty placeholder %s in template %q", m[0], template)
+		}
+		name, ok := strings.CutPrefix(placeholder, "variables.")
+		if !ok || variables == nil {
+			continue
+		}
+		if !variables[name] {
+			return fmt.Errorf("%s references the variable %q, which isn't defined by a variable of this rule", m[0], name)
+		}
+	}
+	return nil
+}
+
+// eventOrchestrationPathSetsValidator fails the plan of a path whose rules
+// route events to sets which don't exist, whose sets aren't all reachable
+// from the `start` set or whose sets route events in a cycle. Paths with
+// values not known yet are left to the API.
+type eventOrchestrationPathSetsValidator struct{}
+
+var _ validator.List = eventOrchestrationPathSetsValidator{}
+
+func (v eventOrchestrationPathSetsValidator) Description(_ context.Context) string {
+	return "sets must all be reachable from the start set, without cycles"
+}
+
+func (v eventOrchestrationPathSetsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v eventOrchestrationPathSetsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var sets []eventOrchestrationPathSetNode
+	for si, e := range req.ConfigValue.Elements() {
+		attrs := objectAttributes(e)
+		id := objectStringAttribute(attrs, "id")
+		rules, _ := attrs["rule"].(types.List)
+		if id.IsUnknown() || rules.IsUnknown() {
+			return
+		}
+		set := eventOrchestrationPathSetNode{id: id.ValueString()}
+
+		for ri, r := range rules.Elements() {
+			actions, _ := objectAttributes(r)["actions"].(types.List)
+			if actions.IsUnknown() {
+				return
+			}
+			routeTo := objectStringAttribute(blockAttributes(actions), "route_to")
+			if routeTo.IsUnknown() {
+				return
+			}
+			if target := routeTo.ValueString(); target != "" {
+				attribute := fmt.Sprintf("set.%d.rule.%d.actions.0.route_to", si, ri)
+				set.routes = append(set.routes, eventOrchestrationPathSetRoute{attribute: attribute, target: target})
+			}
+		}
+		sets = append(sets, set)
+	}
+
+	if errorMsgs := validateEventOrchestrationPathSets(sets); len(errorMsgs) > 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Event Orchestration Path sets", "- "+strings.Join(errorMsgs, "\n- "))
+	}
+}
+
+// eventOrchestrationPathSetNode is a set of a path and the sets its rules
+// route events to.
+type eventOrchestrationPathSetNode struct {
+	id     string
+	routes []eventOrchestrationPathSetRoute
+}
+
+type eventOrchestrationPathSetRoute struct {
+	attribute string
+	target    string
+}
+
+func validateEventOrchestrationPathSets(sets []eventOrchestrationPathSetNode) []string {
+	errorMsgs := []string{}
+
+	index := map[string]int{}
+	for i, set := range sets {
+		if _, ok := index[set.id]; ok {
+			errorMsgs = append(errorMsgs, fmt.Sprintf("set.%d: the ID %q is used by more than one set", i, set.id))
+			continue
+		}
+		index[set.id] = i
+	}
+	for _, set := range sets {
+		for _, route := range set.routes {
+			if _, ok := index[route.target]; !ok {
+				errorMsgs = append(errorMsgs, fmt.Sprintf("%s: no set with the ID %q", route.attribute, route.target))
+			}
+		}
+	}
+	if len(errorMsgs) > 0 {
+		return errorMsgs
+	}
+
+	// Sets are walked from `start`, reporting the first route closing a cycle.
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(sets))
+	var walk func(i int, trail []string)
+	walk = func(i int, trail []string) {
+		state[i] = visiting
+		trail = append(trail, sets[i].id)
+		for _, route := range sets[i].routes {
+			j := index[route.target]
+			switch state[j] {
+			case visiting:
+				cycle := append(trail[indexOf(trail, route.target):], route.target)
+				errorMsgs = append(errorMsgs, fmt.Sprintf("%s: routing events to %q creates a cycle between sets: %s", route.attribute, route.target, strings.Join(cycle, " -> ")))
+			case unvisited:
+				walk(j, trail)
+			}
+		}
+		state[i] = visited
+	}
+	start, ok := index["start"]
+	if !ok {
+		return append(errorMsgs, "a set with the ID \"start\" is required")
+	}
+	walk(start, nil)
+
+	for i, set := range sets {
+		if state[i] == unvisited {
+			errorMsgs = append(errorMsgs, fmt.Sprintf("set.%d: no rule routes events to the set %q from the \"start\" set", i, set.id))
+		}
+	}
+	return errorMsgs
+}
+
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// eventOrchestrationPathDynamicRoutingValidator checks the rules of the
+// `start` set of a Router: only its first rule can have the Dynamic Routing
+// action, without conditions or `route_to`, and the other rules must route
+// events to a service.
+type eventOrchestrationPathDynamicRoutingValidator struct{}
+
+var _ validator.List = eventOrchestrationPathDynamicRoutingValidator{}
+
+func (v eventOrchestrationPathDynamicRoutingValidator) Description(_ context.Context) string {
+	return "only the first rule of a Router can use Dynamic Routing"
+}
+
+func (v eventOrchestrationPathDynamicRoutingValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v eventOrchestrationPathDynamicRoutingValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	draIdxs := []int{}
+	errorMsgs := []string{}
+	for ri, r := range req.ConfigValue.Elements() {
+		actions, _ := objectAttributes(r)["actions"].(types.List)
+		if len(actions.Elements()) == 0 {
+			continue
+		}
+		routeTo := objectStringAttribute(blockAttributes(actions), "route_to")
+		dra, _ := blockAttributes(actions)["dynamic_route_to"].(types.List)
+		if len(dra.Elements()) > 0 || dra.IsUnknown() {
+			draIdxs = append(draIdxs, ri)
+		} else if routeTo.IsNull() {
+			errorMsgs = append(errorMsgs, fmt.Sprintf("Rule %d: at least one of 'route_to' or 'dynamic_route_to' must be specified in actions", ri))
+		}
+	}
+
+	// 1. A Router can have at most one rule with the Dynamic Routing action.
+	if len(draIdxs) > 1 {
+		idxs := []string{}
+		for _, idx := range draIdxs {
+			idxs = append(idxs, fmt.Sprintf("%d", idx))
+		}
+		errorMsgs = append(errorMsgs, fmt.Sprintf("A Router can have at most one Dynamic Routing rule; Rules with the dynamic_route_to action found at indexes: %s", strings.Join(idxs, ", ")))
+	}
+	// 2. The Dynamic Routing action can only be used in the first rule.
+	if len(draIdxs) > 0 && draIdxs[0] != 0 {
+		errorMsgs = append(errorMsgs, "The Dynamic Routing rule must be the first rule in a Router")
+	}
+	// 3. The Dynamic Routing rule cannot have conditions or the `route_to`
+	// action, even if not known yet, as when it references a service.
+	if len(draIdxs) == 1 && draIdxs[0] == 0 {
+		rule := objectAttributes(req.ConfigValue.Elements()[0])
+		if conditions, _ := rule["condition"].(types.List); len(conditions.Elements()) > 0 {
+			errorMsgs = append(errorMsgs, "Dynamic Routing rules cannot have conditions")
+		}
+		actions, _ := rule["actions"].(types.List)
+		if !objectStringAttribute(blockAttributes(actions), "route_to").IsNull() {
+			errorMsgs = append(errorMsgs, "Dynamic Routing rules cannot have the `route_to` action")
+		}
+	}
+
+	if len(errorMsgs) > 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Dynamic Routing rule configuration", "- "+strings.Join(errorMsgs, "\n- "))
+	}
+}
+
+func objectAttributes(v attr.Value) map[string]attr.Value {
+	if obj, ok := v.(types.Object); ok {
+		return obj.Attributes()
+	}
+	return nil
+}
+
+// blockAttributes returns the attributes of the item of a block of at most
+// one item, or nil when the block isn't set or not known yet.
+func blockAttributes(l types.List) map[string]attr.Value {
+	if elements := l.Elements(); len(elements) > 0 {
+		return objectAttributes(elements[0])
+	}
+	return nil
+}
+
+func objectStringAttribute(attrs map[string]attr.Value, name string) types.String {
+	if s, ok := attrs[name].(types.String); ok {
+		return s
+	}
+	return types.StringNull()
+}
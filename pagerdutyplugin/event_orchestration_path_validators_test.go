@@ -0,0 +1,94 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidateEventOrchestrationPathSets(t *testing.T) {
+	route := func(si, ri int, target string) eventOrchestrationPathSetRoute {
+		return eventOrchestrationPathSetRoute{attribute: fmt.Sprintf("set.%d.rule.%d.actions.0.route_to", si, ri), target: target}
+	}
+
+	cases := []struct {
+		name string
+		sets []eventOrchestrationPathSetNode
+		want []string
+	}{
+		{
+			name: "valid",
+			sets: []eventOrchestrationPathSetNode{
+				{id: "start", routes: []eventOrchestrationPathSetRoute{route(0, 0, "set-1"), route(0, 1, "set-2")}},
+				{id: "set-1", routes: []eventOrchestrationPathSetRoute{route(1, 0, "set-2")}},
+				{id: "set-2"},
+			},
+			want: []string{},
+		},
+		{
+			name: "unknown set",
+			sets: []eventOrchestrationPathSetNode{
+				{id: "start", routes: []eventOrchestrationPathSetRoute{route(0, 0, "set-3")}},
+			},
+			want: []string{`set.0.rule.0.actions.0.route_to: no set with the ID "set-3"`},
+		},
+		{
+			name: "unreachable set",
+			sets: []eventOrchestrationPathSetNode{
+				{id: "start"},
+				{id: "set-1"},
+			},
+			want: []string{`set.1: no rule routes events to the set "set-1" from the "start" set`},
+		},
+		{
+			name: "cycle",
+			sets: []eventOrchestrationPathSetNode{
+				{id: "start", routes: []eventOrchestrationPathSetRoute{route(0, 0, "set-1")}},
+				{id: "set-1", routes: []eventOrchestrationPathSetRoute{route(1, 0, "set-2")}},
+				{id: "set-2", routes: []eventOrchestrationPathSetRoute{route(2, 0, "set-1")}},
+			},
+			want: []string{`set.2.rule.0.actions.0.route_to: routing events to "set-1" creates a cycle between sets: set-1 -> set-2 -> set-1`},
+		},
+		{
+			name: "duplicate set",
+			sets: []eventOrchestrationPathSetNode{
+				{id: "start"},
+				{id: "start"},
+			},
+			want: []string{`set.1: the ID "start" is used by more than one set`},
+		},
+	}
+	for _, c := range cases {
+		got := validateEventOrchestrationPathSets(c.sets)
+		if strings.Join(got, "\n") != strings.Join(c.want, "\n") {
+			t.Errorf("%s: expected errors %q, got %q", c.name, c.want, got)
+		}
+	}
+}
+
+func TestValidateEventOrchestrationPathTemplate(t *testing.T) {
+	variables := map[string]bool{"hostname": true}
+
+	cases := []struct {
+		template string
+		valid    bool
+	}{
+		{"{{variables.hostname}} is down", true},
+		{"{{ variables.hostname }} at {{event.timestamp}}", true},
+		{"no placeholder", true},
+		{"{{variables.host}} is down", false},
+		{"{{variables.hostname} is down", false},
+		{"{{}} is down", false},
+	}
+	for _, c := range cases {
+		err := validateEventOrchestrationPathTemplate(c.template, variables)
+		if (err == nil) != c.valid {
+			t.Errorf("%q: expected valid %t, got error %v", c.template, c.valid, err)
+		}
+	}
+
+	// References aren't checked when the names of the variables aren't known.
+	if err := validateEventOrchestrationPathTemplate("{{variables.host}}", nil); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -14,18 +14,19 @@ func TestAccPagerDutyEscalationPolicy_import(t *testing.T) {
 	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEscalationPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEscalationPolicyConfig(username, email, escalationPolicy),
 			},
 
 			{
-				ResourceName:      "pagerduty_escalation_policy.foo",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "pagerduty_escalation_policy.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -16,9 +16,9 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_import(t *testing.T) {
 	orch := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationGlobalPathDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathGlobalDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalAllActionsConfig(team, escalationPolicy, service, orch),
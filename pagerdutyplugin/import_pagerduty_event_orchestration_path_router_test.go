@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func TestAccPagerDutyEventOrchestrationPathRouter_import(t *testing.T) {
@@ -17,9 +16,9 @@ func TestAccPagerDutyEventOrchestrationPathRouter_import(t *testing.T) {
 	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationRouterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathRouterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationRouterConfigWithMultipleRules(team, escalationPolicy, service, orchestration),
@@ -43,16 +42,16 @@ func TestAccPagerDutyEventOrchestrationPathRouterDynamicRouteTo_import(t *testin
 	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
-	dynamicRouteToByNameInput := &pagerduty.EventOrchestrationPathDynamicRouteTo{
+	dynamicRouteToByNameInput := &eventOrchestrationPathDynamicRouteTo{
 		LookupBy: "service_name",
 		Regex:    ".*",
 		Source:   "event.custom_details.pd_service_name",
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationRouterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathRouterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationRouterDynamicRouteToConfig(team, escalationPolicy, service, orchestration, dynamicRouteToByNameInput),
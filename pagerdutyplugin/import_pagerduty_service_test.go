@@ -15,9 +15,9 @@ func TestAccPagerDutyService_import(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service),
@@ -27,6 +27,8 @@ func TestAccPagerDutyService_import(t *testing.T) {
 				ResourceName:      "pagerduty_service.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Values defaulted by the API are read back on import.
+				ImportStateVerifyIgnore: []string{"incident_urgency_rule", "auto_pause_notifications_parameters"},
 			},
 		},
 	})
@@ -39,9 +41,9 @@ func TestAccPagerDutyServiceWithIncidentUrgency_import(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRulesConfig(username, email, escalationPolicy, service),
@@ -51,6 +53,8 @@ func TestAccPagerDutyServiceWithIncidentUrgency_import(t *testing.T) {
 				ResourceName:      "pagerduty_service.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Values defaulted by the API are read back on import.
+				ImportStateVerifyIgnore: []string{"auto_pause_notifications_parameters"},
 			},
 		},
 	})
@@ -63,9 +67,9 @@ func TestAccPagerDutyServiceWithAlertGroupingParameters_import(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfigWithAlertContentGrouping(username, email, escalationPolicy, service),
@@ -83,6 +87,9 @@ func TestAccPagerDutyServiceWithAlertGroupingParameters_import(t *testing.T) {
 				ResourceName:      "pagerduty_service.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// alert_grouping_parameters are left to pagerduty_alert_grouping_setting
+				// on import, and values defaulted by the API are read back.
+				ImportStateVerifyIgnore: []string{"alert_grouping_parameters", "incident_urgency_rule", "auto_pause_notifications_parameters"},
 			},
 		},
 	})
@@ -95,9 +102,9 @@ func TestAccPagerDutyServiceWithAutoPauseNotifications_import(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfigWithAutoPauseNotificationsParameters(username, email, escalationPolicy, service),
@@ -115,6 +122,8 @@ func TestAccPagerDutyServiceWithAutoPauseNotifications_import(t *testing.T) {
 				ResourceName:      "pagerduty_service.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Values defaulted by the API are read back on import.
+				ImportStateVerifyIgnore: []string{"incident_urgency_rule"},
 			},
 		},
 	})
@@ -127,9 +136,9 @@ func TestAccPagerDutyServiceWithAutoPauseNotificationsDisabled_import(t *testing
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfigWithAutoPauseNotificationsParametersUpdated(username, email, escalationPolicy, service),
@@ -147,6 +156,8 @@ func TestAccPagerDutyServiceWithAutoPauseNotificationsDisabled_import(t *testing
 				ResourceName:      "pagerduty_service.foo",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Values defaulted by the API are read back on import.
+				ImportStateVerifyIgnore: []string{"incident_urgency_rule"},
 			},
 		},
 	})
@@ -0,0 +1,18 @@
+package pagerduty
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// checkPreflight adds an error to `diags` when the account is known to lack
+// any of `abilities` or the provider to lack the OAuth `scope` needed to
+// manage a resource of type `resourceType`, instead of waiting for the API
+// to reject the change.
+func checkPreflight(config *Config, resourceType, scope string, abilities []string, diags *diag.Diagnostics) {
+	if config == nil {
+		return
+	}
+	if err := config.Capabilities().Check(resourceType, scope, abilities...); err != nil {
+		diags.AddError("Missing PagerDuty capability", err.Error())
+	}
+}
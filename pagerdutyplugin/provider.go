@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/PagerDuty/go-pagerduty"
@@ -49,6 +50,12 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			"token":                       schema.StringAttribute{Optional: true},
 			"user_token":                  schema.StringAttribute{Optional: true},
 			"insecure_tls":                schema.BoolAttribute{Optional: true},
+			"default_tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"read_only":                   schema.BoolAttribute{Optional: true},
+			"default_deletion_protection": schema.BoolAttribute{Optional: true},
 		},
 		Blocks: map[string]schema.Block{
 			"use_app_oauth_scoped_token": useAppOauthScopedTokenBlock,
@@ -60,6 +67,7 @@ func (p *Provider) DataSources(_ context.Context) [](func() datasource.DataSourc
 	return [](func() datasource.DataSource){
 		func() datasource.DataSource { return &dataSourceAlertGroupingSetting{} },
 		func() datasource.DataSource { return &dataSourceBusinessService{} },
+		func() datasource.DataSource { return &dataSourceEscalationPath{} },
 		func() datasource.DataSource { return &dataSourceEscalationPolicy{} },
 		func() datasource.DataSource { return &dataSourceExtensionSchema{} },
 		func() datasource.DataSource { return &dataSourceIncidentTypeCustomField{} },
@@ -89,9 +97,19 @@ func (p *Provider) Resources(_ context.Context) [](func() resource.Resource) {
 		func() resource.Resource { return &resourceAddon{} },
 		func() resource.Resource { return &resourceAlertGroupingSetting{} },
 		func() resource.Resource { return &resourceBusinessService{} },
+		func() resource.Resource { return &resourceChangeEvent{} },
+		func() resource.Resource { return &resourceEscalationPolicy{} },
+		func() resource.Resource { return &resourceService{} },
 		func() resource.Resource { return serviceCustomFieldValueResource() },
 		func() resource.Resource { return &resourceExtensionServiceNow{} },
 		func() resource.Resource { return &resourceExtension{} },
+		func() resource.Resource { return &resourceEventOrchestrationPathDocument{} },
+		func() resource.Resource { return &resourceEventOrchestrationPathGlobal{} },
+		func() resource.Resource { return &resourceEventOrchestrationPathRouter{} },
+		func() resource.Resource { return &resourceEventOrchestrationPathService{} },
+		func() resource.Resource { return &resourceEventOrchestrationPathUnrouted{} },
+		func() resource.Resource { return &resourceEventOrchestrationRouterRule{} },
+		func() resource.Resource { return &resourceEventOrchestrationServiceRule{} },
 		func() resource.Resource { return &resourceIncidentTypeCustomField{} },
 		func() resource.Resource { return &resourceIncidentType{} },
 		func() resource.Resource { return &resourceJiraCloudAccountMappingRule{} },
@@ -101,10 +119,12 @@ func (p *Provider) Resources(_ context.Context) [](func() resource.Resource) {
 		func() resource.Resource { return &resourceTag{} },
 		func() resource.Resource { return &resourceTeamMembership{} },
 		func() resource.Resource { return &resourceTeam{} },
+		func() resource.Resource { return &resourceTestEvent{} },
 		func() resource.Resource { return &resourceUserHandoffNotificationRule{} },
 		func() resource.Resource { return &resourceUserNotificationRule{} },
 		func() resource.Resource { return &resourceUserContactMethod{} },
 		func() resource.Resource { return &resourceEnablement{} },
+		func() resource.Resource { return &resourceSchedule{} },
 		func() resource.Resource { return &resourceScheduleV2{} },
 	}
 }
@@ -137,9 +157,17 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	skipCredentialsValidation := args.SkipCredentialsValidation.Equal(types.BoolValue(true))
 	insecureTls := args.InsecureTls.Equal(types.BoolValue(true))
 
+	readOnly := args.ReadOnly.ValueBool()
+	if args.ReadOnly.IsNull() {
+		if v, err := strconv.ParseBool(os.Getenv("PAGERDUTY_READ_ONLY")); err == nil {
+			readOnly = v
+		}
+	}
+
 	config := Config{
 		APIURL:              "https://api." + regionAPIURL + "pagerduty.com",
 		AppURL:              "https://app." + regionAPIURL + "pagerduty.com",
+		EventsURL:           "https://events." + regionAPIURL + "pagerduty.com",
 		SkipCredsValidation: skipCredentialsValidation,
 		Token:               args.Token.ValueString(),
 		UserToken:           args.UserToken.ValueString(),
@@ -147,6 +175,16 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		APIURLOverride:      args.APIURLOverride.ValueString(),
 		ServiceRegion:       serviceRegion,
 		InsecureTls:         insecureTls,
+		ReadOnly:            readOnly,
+
+		DefaultDeletionProtection: args.DefaultDeletionProtection.ValueBool(),
+	}
+
+	if !args.DefaultTags.IsNull() && !args.DefaultTags.IsUnknown() {
+		resp.Diagnostics.Append(args.DefaultTags.ElementsAs(ctx, &config.DefaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if config.APIURLOverride == "" && p.apiURLOverride != "" {
@@ -217,8 +255,8 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		resp.Diagnostics.AddError("Cannot obtain plugin client", err.Error())
 	}
 	p.client = client
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = &config
+	resp.ResourceData = &config
 }
 
 type UseAppOauthScopedToken struct {
@@ -235,6 +273,9 @@ type providerArguments struct {
 	APIURLOverride            types.String `tfsdk:"api_url_override"`
 	UseAppOauthScopedToken    types.List   `tfsdk:"use_app_oauth_scoped_token"`
 	InsecureTls               types.Bool   `tfsdk:"insecure_tls"`
+	DefaultTags               types.Set    `tfsdk:"default_tags"`
+	ReadOnly                  types.Bool   `tfsdk:"read_only"`
+	DefaultDeletionProtection types.Bool   `tfsdk:"default_deletion_protection"`
 }
 
 type SchemaGetter interface {
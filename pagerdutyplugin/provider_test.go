@@ -71,7 +71,7 @@ func testAccProtoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServ
 				return nil, err
 			}
 
-			return muxServer.ProviderServer(), nil
+			return util.NewReadOnlyProviderServer(muxServer.ProviderServer()), nil
 		},
 	}
 }
@@ -90,6 +90,16 @@ func testAccTimeNow() time.Time {
 	return util.TimeNowInLoc(name)
 }
 
+// testAccAPIURL returns the URL of the REST API of the region set by the
+// PAGERDUTY_SERVICE_REGION environment variable, for requests go-pagerduty
+// doesn't support.
+func testAccAPIURL() string {
+	if v := os.Getenv("PAGERDUTY_SERVICE_REGION"); v != "" && v != "us" {
+		return "https://api." + v + ".pagerduty.com"
+	}
+	return "https://api.pagerduty.com"
+}
+
 func testAccPreCheckPagerDutyAbility(t *testing.T, ability string) {
 	if v := os.Getenv("PAGERDUTY_TOKEN"); v == "" {
 		t.Fatal("PAGERDUTY_TOKEN must be set for acceptance tests")
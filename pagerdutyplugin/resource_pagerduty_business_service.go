@@ -92,10 +92,7 @@ func (r *resourceBusinessService) Create(ctx context.Context, req resource.Creat
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error creating Business Service %s", plan.Name),
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error creating Business Service %s", plan.Name), err)
 		return
 	}
 
@@ -144,10 +141,7 @@ func (r *resourceBusinessService) Update(ctx context.Context, req resource.Updat
 
 	businessService, err := r.client.UpdateBusinessServiceWithContext(ctx, businessServicePlan)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error updating Business Service %s", businessServicePlan.ID),
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error updating Business Service %s", businessServicePlan.ID), err)
 		return
 	}
 	plan = flattenBusinessService(businessService)
@@ -0,0 +1,204 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+// resourceChangeEvent sends a change event through the Events API v2, so
+// that changes applied by Terraform show up on the timelines of services.
+// Change events can't be read back nor deleted.
+type resourceChangeEvent struct {
+	client *pagerduty.Client
+}
+
+var (
+	_ resource.ResourceWithConfigure  = (*resourceChangeEvent)(nil)
+	_ resource.ResourceWithModifyPlan = (*resourceChangeEvent)(nil)
+)
+
+func (r *resourceChangeEvent) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+}
+
+func (r *resourceChangeEvent) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_change_event"
+}
+
+func (r *resourceChangeEvent) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"routing_key": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"summary": schema.StringAttribute{
+				Required: true,
+			},
+			"source": schema.StringAttribute{
+				Optional: true,
+			},
+			"custom_details": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"sent_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"link": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"href": schema.StringAttribute{Required: true},
+						"text": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan plans `sent_at` as unknown when `triggers` change, as a new
+// change event is sent then.
+func (r *resourceChangeEvent) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("triggers"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("triggers"), &state)...)
+	if resp.Diagnostics.HasError() || plan.Equal(state) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sent_at"), types.StringUnknown())...)
+}
+
+func (r *resourceChangeEvent) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceChangeEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.ID = types.StringValue(id.UniqueId())
+	resp.Diagnostics.Append(r.sendChangeEvent(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceChangeEvent) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	// Change events can't be read back, the state is kept as sent.
+}
+
+// Update sends a new change event only when `triggers` change, other
+// arguments describe the next event to send.
+func (r *resourceChangeEvent) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceChangeEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Triggers.Equal(state.Triggers) {
+		model.SentAt = state.SentAt
+	} else {
+		resp.Diagnostics.Append(r.sendChangeEvent(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceChangeEvent) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceChangeEvent) sendChangeEvent(ctx context.Context, model *resourceChangeEventModel) diag.Diagnostics {
+	event, diags := buildChangeEvent(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	sentAt := time.Now().UTC().Format(time.RFC3339)
+	event.Payload.Timestamp = sentAt
+	log.Printf("[INFO] Sending PagerDuty change event %q", event.Payload.Summary)
+
+	if _, err := r.client.CreateChangeEventWithContext(ctx, event); err != nil {
+		diags.AddError("Error sending PagerDuty change event", err.Error())
+		return diags
+	}
+	model.SentAt = types.StringValue(sentAt)
+	return diags
+}
+
+func buildChangeEvent(ctx context.Context, model *resourceChangeEventModel) (pagerduty.ChangeEvent, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	event := pagerduty.ChangeEvent{
+		RoutingKey: model.RoutingKey.ValueString(),
+		Payload: pagerduty.ChangeEventPayload{
+			Summary: model.Summary.ValueString(),
+			Source:  model.Source.ValueString(),
+		},
+	}
+
+	if !model.CustomDetails.IsNull() && !model.CustomDetails.IsUnknown() {
+		var details map[string]string
+		diags.Append(model.CustomDetails.ElementsAs(ctx, &details, false)...)
+		event.Payload.CustomDetails = make(map[string]interface{}, len(details))
+		for k, v := range details {
+			event.Payload.CustomDetails[k] = v
+		}
+	}
+
+	var links []resourceChangeEventLinkModel
+	diags.Append(model.Links.ElementsAs(ctx, &links, false)...)
+	for _, link := range links {
+		event.Links = append(event.Links, pagerduty.ChangeEventLink{
+			Href: link.Href.ValueString(),
+			Text: link.Text.ValueString(),
+		})
+	}
+	return event, diags
+}
+
+type resourceChangeEventModel struct {
+	ID            types.String `tfsdk:"id"`
+	RoutingKey    types.String `tfsdk:"routing_key"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	Summary       types.String `tfsdk:"summary"`
+	Source        types.String `tfsdk:"source"`
+	CustomDetails types.Map    `tfsdk:"custom_details"`
+	Links         types.List   `tfsdk:"link"`
+	SentAt        types.String `tfsdk:"sent_at"`
+}
+
+type resourceChangeEventLinkModel struct {
+	Href types.String `tfsdk:"href"`
+	Text types.String `tfsdk:"text"`
+}
@@ -0,0 +1,160 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestBuildChangeEvent(t *testing.T) {
+	linkType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"href": types.StringType,
+		"text": types.StringType,
+	}}
+	model := resourceChangeEventModel{
+		RoutingKey: types.StringValue("R0UT1NGK3Y"),
+		Summary:    types.StringValue("Applied the payments stack"),
+		Source:     types.StringValue("terraform"),
+		CustomDetails: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"workspace": types.StringValue("production"),
+		}),
+		Links: types.ListValueMust(linkType, []attr.Value{
+			types.ObjectValueMust(linkType.AttrTypes, map[string]attr.Value{
+				"href": types.StringValue("https://ci.example.test/runs/42"),
+				"text": types.StringNull(),
+			}),
+		}),
+	}
+
+	event, diags := buildChangeEvent(context.Background(), &model)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if event.RoutingKey != "R0UT1NGK3Y" || event.Payload.Summary != "Applied the payments stack" || event.Payload.Source != "terraform" {
+		t.Errorf("unexpected change event %+v", event)
+	}
+	if event.Payload.CustomDetails["workspace"] != "production" {
+		t.Errorf("unexpected custom details %v", event.Payload.CustomDetails)
+	}
+	if len(event.Links) != 1 || event.Links[0].Href != "https://ci.example.test/runs/42" || event.Links[0].Text != "" {
+		t.Errorf("unexpected links %+v", event.Links)
+	}
+
+	model.CustomDetails = types.MapNull(types.StringType)
+	model.Links = types.ListNull(linkType)
+	event, diags = buildChangeEvent(context.Background(), &model)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if event.Payload.CustomDetails != nil || event.Links != nil {
+		t.Errorf("expected no custom details nor links, got %+v", event)
+	}
+}
+
+func TestAccPagerDutyChangeEvent_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	var sentAt string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyChangeEventConfig(username, email, escalationPolicy, service, "v1", "Deploy v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pagerduty_change_event.foo", "id"),
+					resource.TestCheckResourceAttrWith("pagerduty_change_event.foo", "sent_at", func(v string) error {
+						sentAt = v
+						return nil
+					}),
+					resource.TestCheckResourceAttr("pagerduty_change_event.foo", "link.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyChangeEventConfig(username, email, escalationPolicy, service, "v1", "Deploy v1 of the stack"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_change_event.foo", "summary", "Deploy v1 of the stack"),
+					resource.TestCheckResourceAttrWith("pagerduty_change_event.foo", "sent_at", func(v string) error {
+						if v != sentAt {
+							return fmt.Errorf("expected no change event to be sent, sent_at changed from %s to %s", sentAt, v)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyChangeEventConfig(username, email, escalationPolicy, service, "v2", "Deploy v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("pagerduty_change_event.foo", tfjsonpath.New("sent_at")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_change_event.foo", "triggers.version", "v2"),
+					resource.TestCheckResourceAttrSet("pagerduty_change_event.foo", "sent_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyChangeEventConfig(username, email, escalationPolicy, service, version, summary string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name    = "Events API v2"
+  service = pagerduty_service.foo.id
+  type    = "events_api_v2_inbound_integration"
+}
+
+resource "pagerduty_change_event" "foo" {
+  routing_key = pagerduty_service_integration.foo.integration_key
+  summary     = "%s"
+  source      = "terraform"
+  triggers = {
+    version = "%s"
+  }
+  custom_details = {
+    version = "%s"
+  }
+
+  link {
+    href = "https://example.test/releases/%s"
+    text = "Release notes"
+  }
+}
+`, username, email, escalationPolicy, service, summary, version, version, version)
+}
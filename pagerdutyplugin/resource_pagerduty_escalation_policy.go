@@ -0,0 +1,679 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type resourceEscalationPolicy struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure    = (*resourceEscalationPolicy)(nil)
+	_ resource.ResourceWithImportState  = (*resourceEscalationPolicy)(nil)
+	_ resource.ResourceWithModifyPlan   = (*resourceEscalationPolicy)(nil)
+	_ resource.ResourceWithUpgradeState = (*resourceEscalationPolicy)(nil)
+)
+
+func (r *resourceEscalationPolicy) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_escalation_policy"
+}
+
+func (r *resourceEscalationPolicy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{validate.IsAllowedString(util.NoNonPrintableCharsOrSpecialChars)},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Managed by Terraform"),
+			},
+			"num_loops": schema.Int64Attribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    int64default.StaticInt64(0),
+				Validators: []validator.Int64{int64validator.Between(0, 9)},
+			},
+			"teams": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtMost(1)},
+			},
+			"ignore_default_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"tags_all": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						// Rules get new IDs whenever the policy is updated.
+						"id": schema.StringAttribute{Computed: true},
+						"escalation_delay_in_minutes": schema.Int64Attribute{
+							Required:   true,
+							Validators: []validator.Int64{int64validator.AtLeast(1)},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"escalation_rule_assignment_strategy": schema.ListNestedBlock{
+							Validators: []validator.List{listvalidator.SizeAtMost(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Optional:   true,
+										Computed:   true,
+										Default:    stringdefault.StaticString("assign_to_everyone"),
+										Validators: []validator.String{stringvalidator.OneOf("assign_to_everyone", "round_robin")},
+									},
+								},
+							},
+						},
+						"target": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								escalationPolicyTargetsValidator{},
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Optional:   true,
+										Computed:   true,
+										Default:    stringdefault.StaticString("user_reference"),
+										Validators: []validator.String{stringvalidator.OneOf("user_reference", "schedule_reference")},
+									},
+									"id": schema.StringAttribute{Required: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEscalationPolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var ignore types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ignore_default_tags"), &ignore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), planDefaultTags(r.config, ignore))...)
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if deletionProtection.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), planDeletionProtection(r.config))...)
+	}
+
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var teams types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("teams"), &teams)...)
+	var abilities []string
+	if len(teams.Elements()) > 0 {
+		abilities = append(abilities, "teams")
+	}
+	checkPreflight(r.config, "pagerduty_escalation_policy", "escalation_policies.write", abilities, &resp.Diagnostics)
+}
+
+func (r *resourceEscalationPolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEscalationPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := buildEscalationPolicyPayload(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Creating PagerDuty escalation policy %s", plan.Name)
+
+	var created escalationPolicyPayloadEnvelope
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPost, "/escalation_policies", escalationPolicyPayloadEnvelope{EscalationPolicy: plan}, &created)
+		if err != nil {
+			if util.IsBadRequestError(err) || isForbiddenError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error creating PagerDuty escalation policy %s", plan.Name), err)
+		return
+	}
+
+	ep, err := r.requestGetEscalationPolicy(ctx, created.EscalationPolicy.ID, true)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty escalation policy %s", created.EscalationPolicy.ID), err.Error())
+		return
+	}
+
+	tagsAll := model.TagsAll
+	model = flattenEscalationPolicy(ctx, ep, model, &resp.Diagnostics)
+	applyDefaultTags(ctx, r.client, "escalation_policies", ep.ID, types.SetNull(types.StringType), tagsAll, &resp.Diagnostics)
+	model.TagsAll = tagsAll
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEscalationPolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceEscalationPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Reading PagerDuty escalation policy %s", state.ID)
+
+	ep, err := r.requestGetEscalationPolicy(ctx, state.ID.ValueString(), false)
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			log.Printf("[WARN] Removing %s because it's gone", state.ID)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty escalation policy %s", state.ID), err.Error())
+		return
+	}
+
+	if state.IgnoreDefaultTags.IsNull() {
+		state.IgnoreDefaultTags = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	tagsAll := state.TagsAll
+	state = flattenEscalationPolicy(ctx, ep, state, &resp.Diagnostics)
+	state.TagsAll = readDefaultTags(ctx, r.client, "escalation_policies", ep.ID, tagsAll, planDefaultTags(r.config, state.IgnoreDefaultTags), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEscalationPolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceEscalationPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	applyDefaultTags(ctx, r.client, "escalation_policies", id, state.TagsAll, model.TagsAll, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing default tags or deletion_protection alone doesn't need the
+	// policy to be updated.
+	onlyMetadata := req.Plan
+	resp.Diagnostics.Append(onlyMetadata.SetAttribute(ctx, path.Root("tags_all"), state.TagsAll)...)
+	resp.Diagnostics.Append(onlyMetadata.SetAttribute(ctx, path.Root("ignore_default_tags"), state.IgnoreDefaultTags)...)
+	resp.Diagnostics.Append(onlyMetadata.SetAttribute(ctx, path.Root("deletion_protection"), state.DeletionProtection)...)
+	if onlyMetadata.Raw.Equal(req.State.Raw) {
+		model.ID = state.ID
+		model.Rule = state.Rule
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		return
+	}
+
+	plan := buildEscalationPolicyPayload(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Updating PagerDuty escalation policy %s", id)
+
+	err := r.requestPutEscalationPolicy(ctx, id, plan)
+	if err != nil && isForbiddenError(err) {
+		// Accounts without the entitlement to round robin scheduling can't
+		// send escalation rule assignment strategies at all.
+		for idx, rule := range plan.EscalationRules {
+			if rule.EscalationRuleAssignmentStrategy == nil {
+				continue
+			}
+			if rule.EscalationRuleAssignmentStrategy.Type == "round_robin" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rule").AtListIndex(idx).AtName("escalation_rule_assignment_strategy"),
+					fmt.Sprintf("Error updating PagerDuty escalation policy %s", id),
+					fmt.Sprintf("Round Robin Scheduling is available for accounts on the following pricing plans: Business, Digital Operations (legacy) and Enterprise for Incident Management. Therefore, set the escalation_rule_assignment_strategy to 'assign_to_everyone' for the escalation rule at index %d", idx),
+				)
+				return
+			}
+			rule.EscalationRuleAssignmentStrategy = nil
+		}
+		err = r.requestPutEscalationPolicy(ctx, id, plan)
+	}
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error updating PagerDuty escalation policy %s", id), err)
+		return
+	}
+
+	ep, err := r.requestGetEscalationPolicy(ctx, id, false)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty escalation policy %s", id), err.Error())
+		return
+	}
+	tagsAll := model.TagsAll
+	model = flattenEscalationPolicy(ctx, ep, model, &resp.Diagnostics)
+	model.TagsAll = tagsAll
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEscalationPolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	var deletionProtection types.Bool
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkDeletionProtection("pagerduty_escalation_policy", id.ValueString(), deletionProtection, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Deleting PagerDuty escalation policy %s", id)
+
+	// Retrying to give other resources (such as services) time to delete.
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := r.client.DeleteEscalationPolicyWithContext(ctx, id.ValueString())
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting PagerDuty escalation policy %s", id), err.Error())
+		return
+	}
+
+	// giving the API time to catchup
+	time.Sleep(time.Second)
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEscalationPolicy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceEscalationPolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceEscalationPolicy) requestGetEscalationPolicy(ctx context.Context, id string, retryNotFound bool) (*escalationPolicyPayload, error) {
+	return getEscalationPolicyPayload(ctx, r.client, r.config, id, retryNotFound)
+}
+
+// getEscalationPolicyPayload reads an escalation policy with its escalation
+// rule assignment strategies, which accounts without the entitlement to
+// round robin scheduling are forbidden to include.
+func getEscalationPolicyPayload(ctx context.Context, client *pagerduty.Client, config *Config, id string, retryNotFound bool) (*escalationPolicyPayload, error) {
+	include := "?include%5B%5D=escalation_rule_assignment_strategies"
+	var ep escalationPolicyPayloadEnvelope
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := apiRequest(ctx, client, config.apiURL(), http.MethodGet, "/escalation_policies/"+id+include, nil, &ep)
+		if err != nil {
+			if isForbiddenError(err) && include != "" {
+				include = ""
+				return retry.RetryableError(err)
+			}
+			if util.IsBadRequestError(err) || isForbiddenError(err) {
+				return retry.NonRetryableError(err)
+			}
+			if !retryNotFound && util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ep.EscalationPolicy, nil
+}
+
+func (r *resourceEscalationPolicy) requestPutEscalationPolicy(ctx context.Context, id string, plan *escalationPolicyPayload) error {
+	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPut, "/escalation_policies/"+id, escalationPolicyPayloadEnvelope{EscalationPolicy: plan}, nil)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) || isForbiddenError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+type resourceEscalationPolicyModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	NumLoops    types.Int64  `tfsdk:"num_loops"`
+	Teams       types.List   `tfsdk:"teams"`
+	Rule        types.List   `tfsdk:"rule"`
+
+	IgnoreDefaultTags types.Bool `tfsdk:"ignore_default_tags"`
+	TagsAll           types.Set  `tfsdk:"tags_all"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+type escalationPolicyRuleModel struct {
+	ID                               types.String `tfsdk:"id"`
+	EscalationDelayInMinutes         types.Int64  `tfsdk:"escalation_delay_in_minutes"`
+	EscalationRuleAssignmentStrategy types.List   `tfsdk:"escalation_rule_assignment_strategy"`
+	Target                           types.List   `tfsdk:"target"`
+}
+
+type escalationPolicyAssignmentStrategyModel struct {
+	Type types.String `tfsdk:"type"`
+}
+
+type escalationPolicyTargetModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+var (
+	escalationPolicyAssignmentStrategyObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type": types.StringType,
+	}}
+	escalationPolicyTargetObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type": types.StringType,
+		"id":   types.StringType,
+	}}
+	escalationPolicyRuleObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":                                  types.StringType,
+		"escalation_delay_in_minutes":         types.Int64Type,
+		"escalation_rule_assignment_strategy": types.ListType{ElemType: escalationPolicyAssignmentStrategyObjectType},
+		"target":                              types.ListType{ElemType: escalationPolicyTargetObjectType},
+	}}
+)
+
+// escalationPolicyPayload is an escalation policy as sent to and returned by
+// the API. Unlike the client library's, it can have no loops and escalation
+// rule assignment strategies.
+type escalationPolicyPayload struct {
+	ID              string                         `json:"id,omitempty"`
+	Type            string                         `json:"type,omitempty"`
+	Name            string                         `json:"name"`
+	HTMLURL         string                         `json:"html_url,omitempty"`
+	Description     string                         `json:"description,omitempty"`
+	NumLoops        int                            `json:"num_loops"`
+	Teams           []*escalationPolicyReference   `json:"teams"`
+	EscalationRules []*escalationPolicyRulePayload `json:"escalation_rules"`
+}
+
+type escalationPolicyPayloadEnvelope struct {
+	EscalationPolicy *escalationPolicyPayload `json:"escalation_policy"`
+}
+
+type escalationPolicyRulePayload struct {
+	ID                               string                                     `json:"id,omitempty"`
+	EscalationDelayInMinutes         int                                        `json:"escalation_delay_in_minutes"`
+	EscalationRuleAssignmentStrategy *escalationPolicyAssignmentStrategyPayload `json:"escalation_rule_assignment_strategy,omitempty"`
+	Targets                          []*escalationPolicyReference               `json:"targets"`
+}
+
+type escalationPolicyAssignmentStrategyPayload struct {
+	Type string `json:"type"`
+}
+
+type escalationPolicyReference struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+func buildEscalationPolicyPayload(ctx context.Context, model *resourceEscalationPolicyModel, diags *diag.Diagnostics) *escalationPolicyPayload {
+	ep := &escalationPolicyPayload{
+		Type:            "escalation_policy",
+		Name:            model.Name.ValueString(),
+		Description:     model.Description.ValueString(),
+		NumLoops:        int(model.NumLoops.ValueInt64()),
+		Teams:           []*escalationPolicyReference{},
+		EscalationRules: []*escalationPolicyRulePayload{},
+	}
+
+	if !model.Teams.IsNull() && !model.Teams.IsUnknown() {
+		var teams []string
+		diags.Append(model.Teams.ElementsAs(ctx, &teams, false)...)
+		for _, team := range teams {
+			ep.Teams = append(ep.Teams, &escalationPolicyReference{ID: team, Type: "team_reference"})
+		}
+	}
+
+	var rules []escalationPolicyRuleModel
+	diags.Append(model.Rule.ElementsAs(ctx, &rules, false)...)
+	for _, rule := range rules {
+		payload := &escalationPolicyRulePayload{
+			EscalationDelayInMinutes: int(rule.EscalationDelayInMinutes.ValueInt64()),
+			Targets:                  []*escalationPolicyReference{},
+		}
+		if strategy, ok := firstListElement[escalationPolicyAssignmentStrategyModel](ctx, rule.EscalationRuleAssignmentStrategy, diags); ok {
+			payload.EscalationRuleAssignmentStrategy = &escalationPolicyAssignmentStrategyPayload{Type: strategy.Type.ValueString()}
+		}
+
+		var targets []escalationPolicyTargetModel
+		diags.Append(rule.Target.ElementsAs(ctx, &targets, false)...)
+		for _, target := range targets {
+			payload.Targets = append(payload.Targets, &escalationPolicyReference{ID: target.ID.ValueString(), Type: target.Type.ValueString()})
+		}
+		ep.EscalationRules = append(ep.EscalationRules, payload)
+	}
+
+	return ep
+}
+
+// flattenEscalationPolicy sets the values of an escalation policy read from
+// the API onto the prior model, which is the state or the plan just applied.
+func flattenEscalationPolicy(ctx context.Context, ep *escalationPolicyPayload, prior resourceEscalationPolicyModel, diags *diag.Diagnostics) resourceEscalationPolicyModel {
+	model := prior
+	model.ID = types.StringValue(ep.ID)
+	model.Name = types.StringValue(ep.Name)
+	model.Description = types.StringValue(ep.Description)
+	model.NumLoops = types.Int64Value(int64(ep.NumLoops))
+
+	model.Teams = types.ListNull(types.StringType)
+	if len(ep.Teams) > 0 {
+		teams := make([]attr.Value, 0, len(ep.Teams))
+		for _, team := range ep.Teams {
+			teams = append(teams, types.StringValue(team.ID))
+		}
+		model.Teams = types.ListValueMust(types.StringType, teams)
+	}
+
+	var priorRules []escalationPolicyRuleModel
+	if !prior.Rule.IsNull() && !prior.Rule.IsUnknown() {
+		diags.Append(prior.Rule.ElementsAs(ctx, &priorRules, false)...)
+	}
+	imported := prior.Name.IsNull()
+
+	rules := make([]attr.Value, 0, len(ep.EscalationRules))
+	for i, rule := range ep.EscalationRules {
+		var priorRule escalationPolicyRuleModel
+		if i < len(priorRules) {
+			priorRule = priorRules[i]
+		}
+
+		// Blocks can't be computed, so the strategy the API defaults rules
+		// to is only read back once configured. Accounts not allowed to read
+		// strategies keep the configured one.
+		strategy := types.ListNull(escalationPolicyAssignmentStrategyObjectType)
+		configured := len(priorRule.EscalationRuleAssignmentStrategy.Elements()) > 0
+		if s := rule.EscalationRuleAssignmentStrategy; s != nil && (configured || (imported && s.Type != "assign_to_everyone")) {
+			strategy = objectList(escalationPolicyAssignmentStrategyObjectType, types.ObjectValueMust(escalationPolicyAssignmentStrategyObjectType.AttrTypes, map[string]attr.Value{
+				"type": types.StringValue(s.Type),
+			}))
+		} else if configured {
+			strategy = priorRule.EscalationRuleAssignmentStrategy
+		}
+
+		rules = append(rules, types.ObjectValueMust(escalationPolicyRuleObjectType.AttrTypes, map[string]attr.Value{
+			"id":                                  types.StringValue(rule.ID),
+			"escalation_delay_in_minutes":         types.Int64Value(int64(rule.EscalationDelayInMinutes)),
+			"escalation_rule_assignment_strategy": strategy,
+			"target":                              flattenEscalationPolicyTargets(ctx, rule.Targets, priorRule.Target, diags),
+		}))
+	}
+	model.Rule = types.ListValueMust(escalationPolicyRuleObjectType, rules)
+
+	return model
+}
+
+// flattenEscalationPolicyTargets lists the targets of a rule in the order of
+// the prior targets, followed by the targets missing from them.
+func flattenEscalationPolicyTargets(ctx context.Context, targets []*escalationPolicyReference, prior types.List, diags *diag.Diagnostics) types.List {
+	var priorTargets []escalationPolicyTargetModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorTargets, false)...)
+	}
+
+	ordered := make([]*escalationPolicyReference, 0, len(targets))
+	added := map[string]bool{}
+	for _, p := range priorTargets {
+		for _, t := range targets {
+			if t.ID == p.ID.ValueString() && !added[t.ID] {
+				ordered = append(ordered, t)
+				added[t.ID] = true
+				break
+			}
+		}
+	}
+	for _, t := range targets {
+		if !added[t.ID] {
+			ordered = append(ordered, t)
+			added[t.ID] = true
+		}
+	}
+
+	elements := make([]attr.Value, 0, len(ordered))
+	for _, t := range ordered {
+		elements = append(elements, types.ObjectValueMust(escalationPolicyTargetObjectType.AttrTypes, map[string]attr.Value{
+			"type": types.StringValue(normalizeEscalationTargetType(t.Type)),
+			"id":   types.StringValue(t.ID),
+		}))
+	}
+	return types.ListValueMust(escalationPolicyTargetObjectType, elements)
+}
+
+// normalizeEscalationTargetType maps v3-specific reference type aliases back to
+// the canonical type accepted by the resource schema. The v3 Schedules API
+// returns "schedule_v3_reference" for schedules created via /v3/schedules, but
+// the escalation policy resource only exposes "schedule_reference" to users.
+func normalizeEscalationTargetType(t string) string {
+	if t == "schedule_v3_reference" {
+		return "schedule_reference"
+	}
+	return t
+}
+
+// escalationPolicyTargetsValidator checks that a rule doesn't escalate to
+// the same target twice, which the API rejects.
+type escalationPolicyTargetsValidator struct{}
+
+var _ validator.List = escalationPolicyTargetsValidator{}
+
+func (v escalationPolicyTargetsValidator) Description(_ context.Context) string {
+	return "targets of an escalation rule must be unique"
+}
+
+func (v escalationPolicyTargetsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v escalationPolicyTargetsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	seen := map[string]bool{}
+	for i, elem := range req.ConfigValue.Elements() {
+		target, ok := elem.(types.Object)
+		if !ok || target.IsNull() || target.IsUnknown() {
+			continue
+		}
+		attrs := target.Attributes()
+		id := objectStringAttribute(attrs, "id")
+		targetType := objectStringAttribute(attrs, "type")
+		if !isKnownValue(id) || targetType.IsUnknown() {
+			continue
+		}
+		key := targetType.ValueString() + "/" + id.ValueString()
+		if targetType.IsNull() {
+			key = "user_reference/" + id.ValueString()
+		}
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid escalation rule target",
+				fmt.Sprintf("%s is already a target of this escalation rule", id.ValueString()),
+			)
+		}
+		seen[key] = true
+	}
+}
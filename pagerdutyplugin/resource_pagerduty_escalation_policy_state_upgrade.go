@@ -0,0 +1,148 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState upgrades the state written by the SDKv2 implementation of
+// pagerduty_escalation_policy, which kept the assignment strategy rules
+// default to and empty values of unset optional attributes.
+func (r *resourceEscalationPolicy) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   resourceEscalationPolicySchemaV0(),
+			StateUpgrader: upgradeEscalationPolicyStateV0,
+		},
+	}
+}
+
+func resourceEscalationPolicySchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                  schema.StringAttribute{Computed: true},
+			"name":                schema.StringAttribute{Required: true},
+			"description":         schema.StringAttribute{Optional: true},
+			"num_loops":           schema.Int64Attribute{Optional: true},
+			"teams":               schema.ListAttribute{Optional: true, ElementType: types.StringType},
+			"ignore_default_tags": schema.BoolAttribute{Optional: true},
+			"tags_all":            schema.SetAttribute{Computed: true, ElementType: types.StringType},
+			"deletion_protection": schema.BoolAttribute{Optional: true},
+			"rule": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"id":                          schema.StringAttribute{Computed: true},
+					"escalation_delay_in_minutes": schema.Int64Attribute{Required: true},
+					"escalation_rule_assignment_strategy": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{Optional: true},
+						}},
+					},
+					"target": schema.ListNestedAttribute{
+						Required: true,
+						NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{Optional: true},
+							"id":   schema.StringAttribute{Required: true},
+						}},
+					},
+				}},
+			},
+		},
+	}
+}
+
+type resourceEscalationPolicyModelV0 struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	NumLoops           types.Int64  `tfsdk:"num_loops"`
+	Teams              types.List   `tfsdk:"teams"`
+	Rule               types.List   `tfsdk:"rule"`
+	IgnoreDefaultTags  types.Bool   `tfsdk:"ignore_default_tags"`
+	TagsAll            types.Set    `tfsdk:"tags_all"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+type escalationPolicyRuleModelV0 struct {
+	ID                               types.String `tfsdk:"id"`
+	EscalationDelayInMinutes         types.Int64  `tfsdk:"escalation_delay_in_minutes"`
+	EscalationRuleAssignmentStrategy types.List   `tfsdk:"escalation_rule_assignment_strategy"`
+	Target                           types.List   `tfsdk:"target"`
+}
+
+func upgradeEscalationPolicyStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior resourceEscalationPolicyModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := resourceEscalationPolicyModel{
+		ID:                 prior.ID,
+		Name:               prior.Name,
+		Description:        prior.Description,
+		NumLoops:           prior.NumLoops,
+		Teams:              emptyListToNull(prior.Teams),
+		IgnoreDefaultTags:  prior.IgnoreDefaultTags,
+		TagsAll:            prior.TagsAll,
+		DeletionProtection: prior.DeletionProtection,
+	}
+	if model.NumLoops.IsNull() {
+		model.NumLoops = types.Int64Value(0)
+	}
+	if model.IgnoreDefaultTags.IsNull() {
+		model.IgnoreDefaultTags = types.BoolValue(false)
+	}
+	if model.TagsAll.IsNull() {
+		model.TagsAll = stringSliceToSet([]string{})
+	}
+	if model.DeletionProtection.IsNull() {
+		model.DeletionProtection = types.BoolValue(false)
+	}
+
+	diags := &resp.Diagnostics
+
+	var rules []escalationPolicyRuleModelV0
+	diags.Append(prior.Rule.ElementsAs(ctx, &rules, false)...)
+	elements := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		// SDKv2 read back the strategy every rule defaults to, which is
+		// only kept in state once configured now.
+		strategy := types.ListNull(escalationPolicyAssignmentStrategyObjectType)
+		if s, ok := firstListElement[escalationPolicyAssignmentStrategyModel](ctx, rule.EscalationRuleAssignmentStrategy, diags); ok && s.Type.ValueString() != "" && s.Type.ValueString() != "assign_to_everyone" {
+			strategy = rule.EscalationRuleAssignmentStrategy
+		}
+
+		var targets []escalationPolicyTargetModel
+		diags.Append(rule.Target.ElementsAs(ctx, &targets, false)...)
+		targetElements := make([]attr.Value, 0, len(targets))
+		for _, t := range targets {
+			targetType := t.Type
+			if targetType.ValueString() == "" {
+				targetType = types.StringValue("user_reference")
+			}
+			targetElements = append(targetElements, types.ObjectValueMust(escalationPolicyTargetObjectType.AttrTypes, map[string]attr.Value{
+				"type": targetType,
+				"id":   t.ID,
+			}))
+		}
+
+		elements = append(elements, types.ObjectValueMust(escalationPolicyRuleObjectType.AttrTypes, map[string]attr.Value{
+			"id":                                  rule.ID,
+			"escalation_delay_in_minutes":         rule.EscalationDelayInMinutes,
+			"escalation_rule_assignment_strategy": strategy,
+			"target":                              types.ListValueMust(escalationPolicyTargetObjectType, targetElements),
+		}))
+	}
+	model.Rule = types.ListValueMust(escalationPolicyRuleObjectType, elements)
+
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -1,16 +1,22 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"math/big"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -24,17 +30,8 @@ func init() {
 }
 
 func testSweepEscalationPolicy(region string) error {
-	config, err := sharedConfigForRegion(region)
-	if err != nil {
-		return err
-	}
-
-	client, err := config.Client()
-	if err != nil {
-		return err
-	}
-
-	resp, _, err := client.EscalationPolicies.List(&pagerduty.ListEscalationPoliciesOptions{})
+	ctx := context.Background()
+	resp, err := testAccProvider.client.ListEscalationPoliciesWithContext(ctx, pagerduty.ListEscalationPoliciesOptions{})
 	if err != nil {
 		return err
 	}
@@ -42,7 +39,7 @@ func testSweepEscalationPolicy(region string) error {
 	for _, escalation := range resp.EscalationPolicies {
 		if strings.HasPrefix(escalation.Name, "test") || strings.HasPrefix(escalation.Name, "tf-") {
 			log.Printf("Destroying escalation policy %s (%s)", escalation.Name, escalation.ID)
-			if _, err := client.EscalationPolicies.Delete(escalation.ID); err != nil {
+			if err := testAccProvider.client.DeleteEscalationPolicyWithContext(ctx, escalation.ID); err != nil {
 				return err
 			}
 		}
@@ -51,6 +48,134 @@ func testSweepEscalationPolicy(region string) error {
 	return nil
 }
 
+func TestResourcePagerDutyEscalationPolicyUpgradeStateV0(t *testing.T) {
+	// State as written by the SDKv2 implementation of the resource.
+	rawState := []byte(`{
+		"id": "PESCALA",
+		"name": "foo",
+		"description": "Managed by Terraform",
+		"num_loops": null,
+		"teams": [],
+		"ignore_default_tags": null,
+		"tags_all": null,
+		"deletion_protection": null,
+		"rule": [
+			{
+				"id": "PRULE01",
+				"escalation_delay_in_minutes": 10,
+				"escalation_rule_assignment_strategy": [{"type": "assign_to_everyone"}],
+				"target": [{"type": "", "id": "PUSER01"}]
+			},
+			{
+				"id": "PRULE02",
+				"escalation_delay_in_minutes": 20,
+				"escalation_rule_assignment_strategy": [{"type": "round_robin"}],
+				"target": [{"type": "schedule_reference", "id": "PSCHEDU"}]
+			}
+		]
+	}`)
+
+	state := testUpgradeResourceStateV0(t, "pagerduty_escalation_policy", rawState)
+
+	rule := func(i int) *tftypes.AttributePath {
+		return tftypes.NewAttributePath().WithAttributeName("rule").WithElementKeyInt(i)
+	}
+	testCheckUpgradedState(t, state, map[*tftypes.AttributePath]interface{}{
+		tftypes.NewAttributePath().WithAttributeName("name"):                                                            "foo",
+		tftypes.NewAttributePath().WithAttributeName("num_loops"):                                                       int64(0),
+		tftypes.NewAttributePath().WithAttributeName("teams"):                                                           nil,
+		tftypes.NewAttributePath().WithAttributeName("ignore_default_tags"):                                             false,
+		tftypes.NewAttributePath().WithAttributeName("deletion_protection"):                                             false,
+		rule(0).WithAttributeName("escalation_delay_in_minutes"):                                                        int64(10),
+		rule(0).WithAttributeName("escalation_rule_assignment_strategy"):                                                0,
+		rule(0).WithAttributeName("target").WithElementKeyInt(0).WithAttributeName("type"):                              "user_reference",
+		rule(0).WithAttributeName("target").WithElementKeyInt(0).WithAttributeName("id"):                                "PUSER01",
+		rule(1).WithAttributeName("escalation_rule_assignment_strategy").WithElementKeyInt(0).WithAttributeName("type"): "round_robin",
+		rule(1).WithAttributeName("target").WithElementKeyInt(0).WithAttributeName("type"):                              "schedule_reference",
+	})
+}
+
+// testUpgradeResourceStateV0 upgrades a state written by the SDKv2
+// implementation of a resource through the provider server.
+func testUpgradeResourceStateV0(t *testing.T, typeName string, rawState []byte) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol5WithError(New())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range schemaResp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: rawState},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	state, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+// testCheckUpgradedState compares the values of an upgraded state with the
+// expected ones, where a nil value stands for null.
+func testCheckUpgradedState(t *testing.T, state tftypes.Value, expected map[*tftypes.AttributePath]interface{}) {
+	t.Helper()
+	for p, want := range expected {
+		v, _, err := tftypes.WalkAttributePath(state, p)
+		if err != nil {
+			t.Fatalf("%s: %s", p, err)
+		}
+		value := v.(tftypes.Value)
+
+		var got interface{}
+		switch want.(type) {
+		case string:
+			var s string
+			err = value.As(&s)
+			got = s
+		case bool:
+			var b bool
+			err = value.As(&b)
+			got = b
+		case int64:
+			var n big.Float
+			err = value.As(&n)
+			got, _ = n.Int64()
+		case int:
+			// The number of elements of a list, blocks not set being empty.
+			var l []tftypes.Value
+			err = value.As(&l)
+			got = len(l)
+		case nil:
+			if !value.IsNull() {
+				got = value.String()
+			}
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", p, err)
+		}
+		if got != want {
+			t.Errorf("%s: expected %v, got %v", p, want, got)
+		}
+	}
+}
+
 func TestAccPagerDutyEscalationPolicy_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -58,9 +183,9 @@ func TestAccPagerDutyEscalationPolicy_Basic(t *testing.T) {
 	escalationPolicyUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEscalationPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEscalationPolicyConfig(username, email, escalationPolicy),
@@ -115,22 +240,22 @@ func TestAccPagerDutyEscalationPolicyWithRoundRobinAssignmentStrategy(t *testing
 	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEscalationPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEscalationPolicyConfig(username, email, escalationPolicy),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
 					resource.TestCheckResourceAttr(
-						"pagerduty_escalation_policy.foo", "rule.0.escalation_rule_assignment_strategy.0.type", "assign_to_everyone"),
+						"pagerduty_escalation_policy.foo", "rule.0.escalation_rule_assignment_strategy.#", "0"),
 				),
 			},
 			{
 				Config:      testAccCheckPagerDutyEscalationPolicyWithRoundRoundAssignmentStrategyConfig(username, email, escalationPolicy, "not_valid_strategy"),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile(`Must be one of \[\]string{"assign_to_everyone", "round_robin"}`),
+				ExpectError: regexp.MustCompile(`value must be one of: \["assign_to_everyone"\s+"round_robin"\]`),
 			},
 			{
 				Config: testAccCheckPagerDutyEscalationPolicyWithRoundRoundAssignmentStrategyConfig(username, email, escalationPolicy, "round_robin"),
@@ -166,9 +291,9 @@ func TestAccPagerDutyEscalationPolicy_FormatValidation(t *testing.T) {
 	errMessageMatcher := "Name can not be blank, nor contain the characters.*, or any non-printable characters. Trailing white spaces are not allowed either."
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEscalationPolicyDestroy,
 		Steps: []resource.TestStep{
 			// Just a valid name
 			{
@@ -218,9 +343,9 @@ func TestAccPagerDutyEscalationPolicyWithTeams_Basic(t *testing.T) {
 	escalationPolicyUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEscalationPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEscalationPolicyWithTeamsConfig(username, email, team, escalationPolicy),
@@ -264,6 +389,143 @@ func TestAccPagerDutyEscalationPolicyWithTeams_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEscalationPolicy_Defaults(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicyUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_escalation_policy.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyDefaultsConfig(username, email, escalationPolicy, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", escalationPolicy),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "num_loops", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.escalation_delay_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.target.0.type", "user_reference"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.escalation_rule_assignment_strategy.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyDefaultsConfig(username, email, escalationPolicyUpdated, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", escalationPolicyUpdated),
+					resource.TestCheckResourceAttr(resourceName, "num_loops", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEscalationPolicy_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "pagerduty_escalation_policy" "foo" {
+  name = "foo"
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      id = "PUSER01"
+    }
+    target {
+      type = "user_reference"
+      id   = "PUSER01"
+    }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`PUSER01 is already a target of this escalation rule`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEscalationPolicy_SDKv2Compatibility(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_escalation_policy.foo"
+	config := testAccCheckPagerDutyEscalationPolicyDefaultsConfig(username, email, escalationPolicy, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", escalationPolicy),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.escalation_rule_assignment_strategy.0.type", "assign_to_everyone"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", escalationPolicy),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.target.0.type", "user_reference"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEscalationPolicyDestroy(s *terraform.State) error {
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_escalation_policy" {
+			continue
+		}
+
+		ctx := context.Background()
+		if _, err := testAccProvider.client.GetEscalationPolicyWithContext(ctx, r.Primary.ID, &pagerduty.GetEscalationPolicyOptions{}); err == nil {
+			return fmt.Errorf("Escalation Policy still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEscalationPolicyDefaultsConfig(username, email, escalationPolicy string, numLoops int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = %d
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+`, username, email, escalationPolicy, numLoops)
+}
+
 func testAccCheckPagerDutyEscalationPolicyWithRoundRoundAssignmentStrategyConfig(name, email, escalationPolicy, strategy string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -295,20 +557,6 @@ resource "pagerduty_escalation_policy" "foo" {
 `, name, email, escalationPolicy, strategy)
 }
 
-func testAccCheckPagerDutyEscalationPolicyDestroy(s *terraform.State) error {
-	client, _ := testAccProvider.Meta().(*Config).Client()
-	for _, r := range s.RootModule().Resources {
-		if r.Type != "pagerduty_escalation_policy" {
-			continue
-		}
-
-		if _, _, err := client.EscalationPolicies.Get(r.Primary.ID, &pagerduty.GetEscalationPolicyOptions{}); err == nil {
-			return fmt.Errorf("Escalation Policy still exists")
-		}
-	}
-	return nil
-}
-
 func testAccCheckPagerDutyEscalationPolicyExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -319,9 +567,8 @@ func testAccCheckPagerDutyEscalationPolicyExists(n string) resource.TestCheckFun
 			return fmt.Errorf("No Escalation Policy ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		found, _, err := client.EscalationPolicies.Get(rs.Primary.ID, &pagerduty.GetEscalationPolicyOptions{})
+		ctx := context.Background()
+		found, err := testAccProvider.client.GetEscalationPolicyWithContext(ctx, rs.Primary.ID, &pagerduty.GetEscalationPolicyOptions{})
 		if err != nil {
 			return err
 		}
@@ -341,16 +588,11 @@ func testAccExternallyDestroyEscalationPolicy(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 		if rs.Primary.ID == "" {
-			return fmt.Errorf("No Tag ID is set")
-		}
-
-		client, _ := testAccProvider.Meta().(*Config).Client()
-		_, err := client.EscalationPolicies.Delete(rs.Primary.ID)
-		if err != nil {
-			return err
+			return fmt.Errorf("No Escalation Policy ID is set")
 		}
 
-		return nil
+		ctx := context.Background()
+		return testAccProvider.client.DeleteEscalationPolicyWithContext(ctx, rs.Primary.ID)
 	}
 }
 
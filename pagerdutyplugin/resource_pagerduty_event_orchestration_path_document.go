@@ -0,0 +1,263 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type resourceEventOrchestrationPathDocument struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure   = (*resourceEventOrchestrationPathDocument)(nil)
+	_ resource.ResourceWithImportState = (*resourceEventOrchestrationPathDocument)(nil)
+)
+
+type resourceEventOrchestrationPathDocumentModel struct {
+	ID       types.String                   `tfsdk:"id"`
+	Parent   types.String                   `tfsdk:"parent"`
+	Type     types.String                   `tfsdk:"type"`
+	Document eventOrchestrationPathDocument `tfsdk:"document"`
+}
+
+func (r *resourceEventOrchestrationPathDocument) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_event_orchestration_path_document"
+}
+
+func (r *resourceEventOrchestrationPathDocument) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"parent": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf("global", "router", "service", "unrouted"),
+				},
+			},
+			"document": schema.StringAttribute{
+				Required:   true,
+				CustomType: eventOrchestrationPathDocumentType{},
+			},
+		},
+	}
+}
+
+func (r *resourceEventOrchestrationPathDocument) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceEventOrchestrationPathDocument) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEventOrchestrationPathDocumentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &model, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathDocument) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model resourceEventOrchestrationPathDocumentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathType, parent := model.Type.ValueString(), model.Parent.ValueString()
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Path document of type %s for %s", pathType, parent)
+
+	var document map[string]interface{}
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		doc, _, err := eventOrchestrationPathDocumentRequest(ctx, r.client, r.config.apiURL(), http.MethodGet, pathType, parent, nil)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			// The API answers 403 instead of 404 for the path of a deleted service.
+			if util.IsNotFoundError(err) || (pathType == "service" && isForbiddenError(err)) {
+				return nil
+			}
+			return retry.RetryableError(err)
+		}
+		document = doc
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading Event Orchestration Path of type %s for %s", pathType, parent), err.Error())
+		return
+	}
+	if document == nil {
+		log.Printf("[WARN] Removing %s because it's gone", model.ID.String())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	for _, k := range eventOrchestrationPathServerFields {
+		delete(document, k)
+	}
+	b, err := json.Marshal(document)
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding Event Orchestration Path document", err.Error())
+		return
+	}
+	// A document semantically equal to the one in state is kept as is.
+	model.Document = newEventOrchestrationPathDocumentValue(string(b))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathDocument) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model resourceEventOrchestrationPathDocumentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &model, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// EventOrchestrationPath cannot be deleted, an empty path is sent instead.
+func (r *resourceEventOrchestrationPathDocument) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model resourceEventOrchestrationPathDocumentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathType, parent := model.Type.ValueString(), model.Parent.ValueString()
+	log.Printf("[INFO] Deleting PagerDuty Event Orchestration Path document of type %s for %s", pathType, parent)
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		_, _, err := eventOrchestrationPathDocumentRequest(ctx, r.client, r.config.apiURL(), http.MethodPut, pathType, parent, emptyEventOrchestrationPathDocument(pathType))
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			if util.IsNotFoundError(err) || (pathType == "service" && isForbiddenError(err)) {
+				return nil
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting Event Orchestration Path of type %s for %s", pathType, parent), err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEventOrchestrationPathDocument) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pathType, parent, ok := strings.Cut(req.ID, ":")
+	if _, known := eventOrchestrationPathTypes[pathType]; !ok || !known || parent == "" {
+		resp.Diagnostics.AddError(
+			"Error importing pagerduty_event_orchestration_path_document",
+			"Expected import ID format: <type>:<parent_id>, where type is one of global, router, service or unrouted",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), pathType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent"), parent)...)
+}
+
+// put sends the document of the path. When `keepRuleIDs` is set, its rules
+// are given the IDs of the rules they match in the path it replaces, which
+// is read from the API, as the document in state usually has no rule IDs.
+func (r *resourceEventOrchestrationPathDocument) put(ctx context.Context, model *resourceEventOrchestrationPathDocumentModel, keepRuleIDs bool, diags *diag.Diagnostics) {
+	pathType, parent := model.Type.ValueString(), model.Parent.ValueString()
+
+	document, err := decodeEventOrchestrationPathDocument(model.Document.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("document"), "Invalid Event Orchestration Path document", err.Error())
+		return
+	}
+
+	if keepRuleIDs {
+		var prior map[string]interface{}
+		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			doc, _, err := eventOrchestrationPathDocumentRequest(ctx, r.client, r.config.apiURL(), http.MethodGet, pathType, parent, nil)
+			if err != nil {
+				if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			prior = doc
+			return nil
+		})
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error reading Event Orchestration Path of type %s for %s", pathType, parent), err.Error())
+			return
+		}
+		keepEventOrchestrationPathRuleIDs(prior, document)
+	}
+
+	log.Printf("[INFO] Updating PagerDuty Event Orchestration Path document of type %s for %s", pathType, parent)
+
+	var warnings []string
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		_, w, err := eventOrchestrationPathDocumentRequest(ctx, r.client, r.config.apiURL(), http.MethodPut, pathType, parent, document)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		warnings = w
+		return nil
+	})
+	if err != nil {
+		diags.AddAttributeError(path.Root("document"), fmt.Sprintf("Error updating Event Orchestration Path of type %s for %s", pathType, parent), err.Error())
+		return
+	}
+
+	for _, w := range warnings {
+		diags.AddWarning("Event Orchestration Path warning", w)
+	}
+	model.ID = types.StringValue(fmt.Sprintf("%s:%s", pathType, parent))
+}
+
+func isForbiddenError(err error) bool {
+	if details, ok := util.ParseAPIError(err); ok {
+		return details.StatusCode == http.StatusForbidden
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestNormalizeEventOrchestrationPathDocument(t *testing.T) {
+	config := `{
+		"sets": [{"id": "start", "rules": [{
+			"label": "Critical disk alerts",
+			"conditions": [{"expression": "event.summary matches part 'disk'"}],
+			"actions": {"route_to": "PSERVICE"}
+		}]}],
+		"catch_all": {"actions": {"route_to": "unrouted"}}
+	}`
+	exported := `{"orchestration_path": {
+		"type": "router",
+		"parent": {"id": "abc", "type": "event_orchestration_reference"},
+		"version": "Ab12",
+		"updated_at": "2026-10-01T00:00:00Z",
+		"catch_all": {"actions": {"route_to": "unrouted"}},
+		"sets": [{"id": "start", "rules": [{
+			"id": "2d3a8c1f",
+			"label": "Critical disk alerts",
+			"disabled": false,
+			"conditions": [{"expression": "event.summary matches part 'disk'"}],
+			"actions": {"route_to": "PSERVICE", "dynamic_route_to": null}
+		}]}]
+	}}`
+
+	a, err := normalizeEventOrchestrationPathDocument(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := normalizeEventOrchestrationPathDocument(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("expected equal documents, got:\n%s\n%s", a, b)
+	}
+
+	equal, diags := newEventOrchestrationPathDocumentValue(config).StringSemanticEquals(context.Background(), newEventOrchestrationPathDocumentValue(exported))
+	if diags.HasError() || !equal {
+		t.Errorf("expected semantically equal documents: %v", diags)
+	}
+
+	changed := `{"sets": [{"id": "start", "rules": [{"label": "Critical disk alerts", "disabled": true, "conditions": [{"expression": "event.summary matches part 'disk'"}], "actions": {"route_to": "PSERVICE"}}]}], "catch_all": {"actions": {"route_to": "unrouted"}}}`
+	c, err := normalizeEventOrchestrationPathDocument(changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == c {
+		t.Error("expected a disabled rule to make documents differ")
+	}
+
+	// Set IDs are part of the document, unlike rule IDs.
+	if d, _ := normalizeEventOrchestrationPathDocument(`{"sets": [{"id": "other", "rules": []}]}`); d != `{"sets":[{"id":"other"}]}` {
+		t.Errorf("unexpected normalized document %s", d)
+	}
+}
+
+func TestKeepEventOrchestrationPathRuleIDs(t *testing.T) {
+	prior, err := decodeEventOrchestrationPathDocument(`{"sets": [
+		{"id": "start", "rules": [
+			{"id": "r-disk", "label": "Disk"},
+			{"id": "r-cpu", "label": "CPU"},
+			{"id": "r-1", "label": ""}
+		]},
+		{"id": "other", "rules": [{"id": "r-other", "label": "Other"}]}
+	]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	document, err := decodeEventOrchestrationPathDocument(`{"sets": [
+		{"id": "start", "rules": [
+			{"label": "Memory"},
+			{"label": "CPU"},
+			{"label": "Disk"},
+			{"label": ""}
+		]},
+		{"id": "renamed", "rules": [{"label": "Other"}]},
+		{"id": "explicit", "rules": [{"id": "r-cpu", "label": "Pinned"}]}
+	]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keepEventOrchestrationPathRuleIDs(prior, document)
+
+	var ids []interface{}
+	for _, s := range eventOrchestrationPathDocumentList(document, "sets") {
+		for _, r := range eventOrchestrationPathDocumentList(s, "rules") {
+			ids = append(ids, r["id"])
+		}
+	}
+	// A rule given an ID explicitly keeps it, even if its label matches
+	// another rule.
+	expected := []interface{}{nil, nil, "r-disk", "r-1", nil, "r-cpu"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("expected rule IDs %v, got %v", expected, ids)
+	}
+}
+
+func TestAccPagerDutyEventOrchestrationPathDocument_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_path_document.router"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathDocumentConfig(name, "disk"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "parent", "pagerduty_event_orchestration.test", "id"),
+					testAccCheckPagerDutyEventOrchestrationPathDocumentRules(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathDocumentConfig(name, "cpu"),
+				Check:  testAccCheckPagerDutyEventOrchestrationPathDocumentRules(resourceName, 1),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"document"},
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathDocumentDestroy(s *terraform.State) error {
+	client := testAccProvider.client
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_event_orchestration_path_document" {
+			continue
+		}
+		router, err := client.GetOrchestrationRouterWithContext(context.Background(), r.Primary.Attributes["parent"], nil)
+		if err != nil {
+			continue
+		}
+		for _, set := range router.Sets {
+			if len(set.Rules) > 0 {
+				return fmt.Errorf("Event Orchestration Router %s still has rules", r.Primary.Attributes["parent"])
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathDocumentRules(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		router, err := testAccProvider.client.GetOrchestrationRouterWithContext(context.Background(), rs.Primary.Attributes["parent"], nil)
+		if err != nil {
+			return err
+		}
+		if len(router.Sets) != 1 || len(router.Sets[0].Rules) != count {
+			return fmt.Errorf("Expected %d rules in the Router of %s", count, rs.Primary.Attributes["parent"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathDocumentConfig(name, summary string) string {
+	return fmt.Sprintf(`
+data "pagerduty_escalation_policy" "test" {
+	name = "Default"
+}
+
+resource "pagerduty_service" "test" {
+	name = "%[1]s"
+	escalation_policy = data.pagerduty_escalation_policy.test.id
+}
+
+resource "pagerduty_event_orchestration" "test" {
+	name = "%[1]s"
+}
+
+resource "pagerduty_event_orchestration_path_document" "router" {
+	parent = pagerduty_event_orchestration.test.id
+	type   = "router"
+	document = jsonencode({
+		sets = [{
+			id = "start"
+			rules = [{
+				label      = "Route %[2]s alerts"
+				conditions = [{ expression = "event.summary matches part '%[2]s'" }]
+				actions    = { route_to = pagerduty_service.test.id }
+			}]
+		}]
+		catch_all = { actions = { route_to = "unrouted" } }
+	})
+}
+`, name, summary)
+}
@@ -0,0 +1,349 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceEventOrchestrationPathGlobal struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure    = (*resourceEventOrchestrationPathGlobal)(nil)
+	_ resource.ResourceWithImportState  = (*resourceEventOrchestrationPathGlobal)(nil)
+	_ resource.ResourceWithModifyPlan   = (*resourceEventOrchestrationPathGlobal)(nil)
+	_ resource.ResourceWithUpgradeState = (*resourceEventOrchestrationPathGlobal)(nil)
+)
+
+func (r *resourceEventOrchestrationPathGlobal) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_event_orchestration_global"
+}
+
+func (r *resourceEventOrchestrationPathGlobal) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleActions := eventOrchestrationPathGlobalActionsBlock()
+	ruleActions.NestedObject.Attributes["route_to"] = schema.StringAttribute{Optional: true}
+
+	resp.Schema = eventOrchestrationPathSchema("event_orchestration", map[string]schema.Attribute{},
+		ruleActions,
+		eventOrchestrationPathGlobalActionsBlock(),
+		[]validator.List{eventOrchestrationPathSetsValidator{}},
+		nil,
+	)
+}
+
+// eventOrchestrationPathGlobalActionsBlock is the `actions` block of the
+// catch-all of the path, which the rules extend with `route_to`.
+func eventOrchestrationPathGlobalActionsBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"drop_event":        schema.BoolAttribute{Optional: true},
+				"suppress":          schema.BoolAttribute{Optional: true},
+				"suspend":           schema.Int64Attribute{Optional: true},
+				"priority":          schema.StringAttribute{Optional: true},
+				"annotate":          schema.StringAttribute{Optional: true},
+				"severity":          eventOrchestrationPathSeverityAttribute(),
+				"event_action":      eventOrchestrationPathEventActionAttribute(),
+				"escalation_policy": schema.StringAttribute{Optional: true},
+			},
+			Blocks: map[string]schema.Block{
+				"automation_action":            eventOrchestrationPathAutomationActionBlock(),
+				"variable":                     eventOrchestrationPathVariableBlock(),
+				"extraction":                   eventOrchestrationPathExtractionBlock(),
+				"incident_custom_field_update": eventOrchestrationPathIncidentCustomFieldUpdateBlock(),
+			},
+			Validators: []validator.Object{eventOrchestrationPathTemplateValidator{}},
+		},
+	}
+}
+
+func (r *resourceEventOrchestrationPathGlobal) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceEventOrchestrationPathGlobalModel
+	if d := req.Plan.Get(ctx, &plan); d.HasError() {
+		// Blocks not known yet, generated by dynamic blocks, are left to the apply.
+		return
+	}
+	var state resourceEventOrchestrationPathGlobalModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	planEventOrchestrationPathRuleIDs(state.Sets, plan.Sets)
+
+	var references []eventOrchestrationReference
+	rulePaths, catchAllPath := eventOrchestrationPathActionsPaths(plan.Sets)
+	i := 0
+	for _, s := range plan.Sets {
+		for _, rule := range s.Rules {
+			if actions := eventOrchestrationPathBlock(rule.Actions); actions != nil {
+				references = append(references, eventOrchestrationReference{rulePaths[i].AtName("escalation_policy"), eventOrchestrationReferenceEscalationPolicy, actions.EscalationPolicy})
+			}
+			i++
+		}
+	}
+	if actions := eventOrchestrationPathCatchAllActions(plan.CatchAll); actions != nil {
+		references = append(references, eventOrchestrationReference{catchAllPath.AtName("escalation_policy"), eventOrchestrationReferenceEscalationPolicy, actions.EscalationPolicy})
+	}
+	checkEventOrchestrationReferences(ctx, r.client, r.config.apiURL(), references, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *resourceEventOrchestrationPathGlobal) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEventOrchestrationPathGlobalModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, resourceEventOrchestrationPathGlobalModel{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathGlobal) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceEventOrchestrationPathGlobalModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	globalPath, err := getEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "global", id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading PagerDuty Event Orchestration Path of type global for "+id, err.Error())
+		return
+	}
+	if globalPath == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = flattenEventOrchestrationPathGlobal(id, globalPath, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEventOrchestrationPathGlobal) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceEventOrchestrationPathGlobalModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathGlobal) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceEventOrchestrationPathGlobalModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "global", state.EventOrchestration.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEventOrchestrationPathGlobal) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+// ImportState imports the global path of an orchestration given its ID.
+func (r *resourceEventOrchestrationPathGlobal) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("event_orchestration"), req.ID)...)
+}
+
+func (r *resourceEventOrchestrationPathGlobal) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return eventOrchestrationPathStateUpgraders(resp.Schema)
+}
+
+func (r *resourceEventOrchestrationPathGlobal) put(ctx context.Context, model, state resourceEventOrchestrationPathGlobalModel, diags *diag.Diagnostics) resourceEventOrchestrationPathGlobalModel {
+	id := model.EventOrchestration.ValueString()
+	globalPath := &eventOrchestrationPath{
+		Parent: &eventOrchestrationPathReference{ID: id},
+		Sets:   expandEventOrchestrationPathSets(model.Sets, eventOrchestrationPathRuleIDs(state.Sets, model.Sets), expandEventOrchestrationPathGlobalActions),
+		CatchAll: &eventOrchestrationPathCatchAll{
+			Actions: expandEventOrchestrationPathGlobalActions(eventOrchestrationPathCatchAllActions(model.CatchAll).ruleActions()),
+		},
+	}
+
+	globalPath = putEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "global", id, globalPath, diags)
+	if diags.HasError() {
+		return model
+	}
+	// The response holds the IDs of the rules just created.
+	return flattenEventOrchestrationPathGlobal(id, globalPath, model)
+}
+
+type resourceEventOrchestrationPathGlobalModel struct {
+	ID                 types.String                                                                            `tfsdk:"id"`
+	EventOrchestration types.String                                                                            `tfsdk:"event_orchestration"`
+	Sets               []eventOrchestrationPathSetModel[eventOrchestrationPathGlobalActionsModel]              `tfsdk:"set"`
+	CatchAll           []eventOrchestrationPathCatchAllModel[eventOrchestrationPathGlobalCatchAllActionsModel] `tfsdk:"catch_all"`
+}
+
+type eventOrchestrationPathGlobalActionsModel struct {
+	DropEvent                  types.Bool                                             `tfsdk:"drop_event"`
+	RouteTo                    types.String                                           `tfsdk:"route_to"`
+	Suppress                   types.Bool                                             `tfsdk:"suppress"`
+	Suspend                    types.Int64                                            `tfsdk:"suspend"`
+	Priority                   types.String                                           `tfsdk:"priority"`
+	Annotate                   types.String                                           `tfsdk:"annotate"`
+	AutomationAction           []eventOrchestrationPathAutomationActionModel          `tfsdk:"automation_action"`
+	Severity                   types.String                                           `tfsdk:"severity"`
+	EventAction                types.String                                           `tfsdk:"event_action"`
+	Variables                  []eventOrchestrationPathVariableModel                  `tfsdk:"variable"`
+	Extractions                []eventOrchestrationPathExtractionModel                `tfsdk:"extraction"`
+	IncidentCustomFieldUpdates []eventOrchestrationPathIncidentCustomFieldUpdateModel `tfsdk:"incident_custom_field_update"`
+	EscalationPolicy           types.String                                           `tfsdk:"escalation_policy"`
+}
+
+// eventOrchestrationPathGlobalCatchAllActionsModel are the actions of the
+// rules but `route_to`.
+type eventOrchestrationPathGlobalCatchAllActionsModel struct {
+	DropEvent                  types.Bool                                             `tfsdk:"drop_event"`
+	Suppress                   types.Bool                                             `tfsdk:"suppress"`
+	Suspend                    types.Int64                                            `tfsdk:"suspend"`
+	Priority                   types.String                                           `tfsdk:"priority"`
+	Annotate                   types.String                                           `tfsdk:"annotate"`
+	AutomationAction           []eventOrchestrationPathAutomationActionModel          `tfsdk:"automation_action"`
+	Severity                   types.String                                           `tfsdk:"severity"`
+	EventAction                types.String                                           `tfsdk:"event_action"`
+	Variables                  []eventOrchestrationPathVariableModel                  `tfsdk:"variable"`
+	Extractions                []eventOrchestrationPathExtractionModel                `tfsdk:"extraction"`
+	IncidentCustomFieldUpdates []eventOrchestrationPathIncidentCustomFieldUpdateModel `tfsdk:"incident_custom_field_update"`
+	EscalationPolicy           types.String                                           `tfsdk:"escalation_policy"`
+}
+
+func (a *eventOrchestrationPathGlobalCatchAllActionsModel) ruleActions() *eventOrchestrationPathGlobalActionsModel {
+	if a == nil {
+		return nil
+	}
+	return &eventOrchestrationPathGlobalActionsModel{
+		DropEvent:                  a.DropEvent,
+		RouteTo:                    types.StringNull(),
+		Suppress:                   a.Suppress,
+		Suspend:                    a.Suspend,
+		Priority:                   a.Priority,
+		Annotate:                   a.Annotate,
+		AutomationAction:           a.AutomationAction,
+		Severity:                   a.Severity,
+		EventAction:                a.EventAction,
+		Variables:                  a.Variables,
+		Extractions:                a.Extractions,
+		IncidentCustomFieldUpdates: a.IncidentCustomFieldUpdates,
+		EscalationPolicy:           a.EscalationPolicy,
+	}
+}
+
+func (a *eventOrchestrationPathGlobalActionsModel) catchAllActions() *eventOrchestrationPathGlobalCatchAllActionsModel {
+	return &eventOrchestrationPathGlobalCatchAllActionsModel{
+		DropEvent:                  a.DropEvent,
+		Suppress:                   a.Suppress,
+		Suspend:                    a.Suspend,
+		Priority:                   a.Priority,
+		Annotate:                   a.Annotate,
+		AutomationAction:           a.AutomationAction,
+		Severity:                   a.Severity,
+		EventAction:                a.EventAction,
+		Variables:                  a.Variables,
+		Extractions:                a.Extractions,
+		IncidentCustomFieldUpdates: a.IncidentCustomFieldUpdates,
+		EscalationPolicy:           a.EscalationPolicy,
+	}
+}
+
+func expandEventOrchestrationPathGlobalActions(a *eventOrchestrationPathGlobalActionsModel) *eventOrchestrationPathActions {
+	actions := &eventOrchestrationPathActions{
+		AutomationActions:          []*eventOrchestrationPathAutomationAction{},
+		Variables:                  []*eventOrchestrationPathVariable{},
+		Extractions:                []*eventOrchestrationPathExtraction{},
+		IncidentCustomFieldUpdates: []*eventOrchestrationPathIncidentCustomFieldUpdate{},
+	}
+	if a == nil {
+		return actions
+	}
+
+	actions.DropEvent = a.DropEvent.ValueBool()
+	actions.RouteTo = a.RouteTo.ValueString()
+	actions.Suppress = a.Suppress.ValueBool()
+	actions.Suspend = util.IntTypeToIntPtr(int(a.Suspend.ValueInt64()))
+	actions.Priority = a.Priority.ValueString()
+	actions.Annotate = a.Annotate.ValueString()
+	actions.AutomationActions = expandEventOrchestrationPathAutomationActions(a.AutomationAction)
+	actions.Severity = a.Severity.ValueString()
+	actions.EventAction = a.EventAction.ValueString()
+	actions.Variables = expandEventOrchestrationPathVariables(a.Variables)
+	actions.Extractions = expandEventOrchestrationPathExtractions(a.Extractions)
+	actions.IncidentCustomFieldUpdates = expandEventOrchestrationPathIncidentCustomFieldUpdates(a.IncidentCustomFieldUpdates)
+	actions.EscalationPolicy = util.StringTypeToStringPtr(a.EscalationPolicy.ValueString())
+	return actions
+}
+
+func flattenEventOrchestrationPathGlobal(id string, p *eventOrchestrationPath, prior resourceEventOrchestrationPathGlobalModel) resourceEventOrchestrationPathGlobalModel {
+	model := resourceEventOrchestrationPathGlobalModel{
+		ID:                 types.StringValue(id),
+		EventOrchestration: types.StringValue(id),
+		Sets:               flattenEventOrchestrationPathSets(p.Sets, prior.Sets, flattenEventOrchestrationPathGlobalActions),
+	}
+
+	priorActions := eventOrchestrationPathCatchAllActions(prior.CatchAll).ruleActions()
+	var actions *eventOrchestrationPathActions
+	if p.CatchAll != nil {
+		actions = p.CatchAll.Actions
+	}
+	model.CatchAll = newEventOrchestrationPathCatchAll(flattenEventOrchestrationPathGlobalActions(actions, priorActions).catchAllActions())
+	return model
+}
+
+func flattenEventOrchestrationPathGlobalActions(a *eventOrchestrationPathActions, prior *eventOrchestrationPathGlobalActionsModel) *eventOrchestrationPathGlobalActionsModel {
+	if a == nil {
+		a = new(eventOrchestrationPathActions)
+	}
+	if prior == nil {
+		prior = new(eventOrchestrationPathGlobalActionsModel)
+	}
+
+	return &eventOrchestrationPathGlobalActionsModel{
+		DropEvent:                  eventOrchestrationPathBool(a.DropEvent, prior.DropEvent),
+		RouteTo:                    eventOrchestrationPathString(a.RouteTo, prior.RouteTo),
+		Suppress:                   eventOrchestrationPathBool(a.Suppress, prior.Suppress),
+		Suspend:                    eventOrchestrationPathInt64(a.Suspend, prior.Suspend),
+		Priority:                   eventOrchestrationPathString(a.Priority, prior.Priority),
+		Annotate:                   eventOrchestrationPathString(a.Annotate, prior.Annotate),
+		AutomationAction:           flattenEventOrchestrationPathAutomationActions(a.AutomationActions, prior.AutomationAction),
+		Severity:                   eventOrchestrationPathString(a.Severity, prior.Severity),
+		EventAction:                eventOrchestrationPathString(a.EventAction, prior.EventAction),
+		Variables:                  flattenEventOrchestrationPathVariables(a.Variables),
+		Extractions:                flattenEventOrchestrationPathExtractions(a.Extractions, prior.Extractions),
+		IncidentCustomFieldUpdates: flattenEventOrchestrationPathIncidentCustomFieldUpdates(a.IncidentCustomFieldUpdates),
+		EscalationPolicy:           eventOrchestrationPathStringPtr(a.EscalationPolicy, prior.EscalationPolicy),
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -33,9 +34,9 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationGlobalPathDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathGlobalDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationGlobalDefaultConfig(team, escalationPolicy, service, orch),
@@ -72,21 +73,21 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
 					team, escalationPolicy, service, orch, invalidExtractionRegexTemplateNilConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: regex and template cannot both be null"),
+				ExpectError: regexp.MustCompile("regex and template cannot both be null"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalInvalidExtractionsConfig(
 					team, escalationPolicy, service, orch, invalidExtractionRegexTemplateValConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: regex and template cannot both have values"),
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalInvalidExtractionsConfig(
 					team, escalationPolicy, service, orch, invalidExtractionRegexNilSourceConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: source can't be blank"),
+				ExpectError: regexp.MustCompile("source can't be blank"),
 			},
 			// Providing invalid extractions attributes for the catch_all rule
 			{
@@ -94,21 +95,21 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
 					team, escalationPolicy, service, orch, "", invalidExtractionRegexTemplateNilConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: regex and template cannot both be null"),
+				ExpectError: regexp.MustCompile("regex and template cannot both be null"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalInvalidExtractionsConfig(
 					team, escalationPolicy, service, orch, "", invalidExtractionRegexTemplateValConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: regex and template cannot both have values"),
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalInvalidExtractionsConfig(
 					team, escalationPolicy, service, orch, "", invalidExtractionRegexNilSourceConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: source can't be blank"),
+				ExpectError: regexp.MustCompile("source can't be blank"),
 			},
 			// Adding/updating/deleting all actions
 			{
@@ -135,8 +136,8 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
 							resource.TestCheckResourceAttrSet(res, "set.0.rule.1.id"),
 							resource.TestCheckResourceAttrSet(res, "set.1.rule.0.id"),
 							resource.TestCheckResourceAttrSet(res, "set.1.rule.1.id"),
-							resource.TestCheckResourceAttr(
-								res, "set.0.rule.0.actions.0.escalation_policy", "POLICY3",
+							resource.TestCheckResourceAttrPair(
+								res, "set.0.rule.0.actions.0.escalation_policy", "pagerduty_escalation_policy.foo", "id",
 							),
 						}...,
 					)...,
@@ -176,17 +177,81 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
 	})
 }
 
-func testAccCheckPagerDutyEventOrchestrationGlobalPathDestroy(s *terraform.State) error {
-	client, _ := testAccProvider.Meta().(*Config).Client()
+func TestAccPagerDutyEventOrchestrationPathGlobal_EscalationPolicy(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_global.global"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathGlobalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalConfig(name, "P1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "event_orchestration", "pagerduty_event_orchestration.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "set.0.rule.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.route_to", "step-two"),
+					resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.annotate", "P1"),
+					resource.TestCheckResourceAttrPair(resourceName, "set.1.rule.0.actions.0.escalation_policy", "data.pagerduty_escalation_policy.test", "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "set.1.rule.0.actions.0.drop_event"),
+					resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.suspend", "120"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalConfig(name, "P2"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.annotate", "P2"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathGlobalUnknownEscalationPolicyConfig(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Escalation Policy "PNOTEXI" doesn't exist`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEventOrchestrationPathGlobal_SDKv2Compatibility(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_global.global"
+	config := testAccCheckPagerDutyEventOrchestrationPathGlobalConfig(name, "P1")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check:             resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.suspend", "120"),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check:                    resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.suspend", "120"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalDestroy(s *terraform.State) error {
 	for _, r := range s.RootModule().Resources {
 		if r.Type != "pagerduty_event_orchestration_global" {
 			continue
 		}
-
-		orch, _ := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
-
-		if _, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "global"); err == nil {
-			return fmt.Errorf("Event Orchestration Path still exists")
+		globalPath, err := getEventOrchestrationPath(context.Background(), testAccProvider.client, testAccAPIURL(), "global", r.Primary.ID)
+		if err != nil || globalPath == nil {
+			continue
+		}
+		for _, set := range globalPath.Sets {
+			if len(set.Rules) > 0 {
+				return fmt.Errorf("Event Orchestration Global Path %s still has rules", r.Primary.ID)
+			}
 		}
 	}
 	return nil
@@ -202,11 +267,10 @@ func testAccCheckPagerDutyEventOrchestrationGlobalExists(rn string) resource.Tes
 			return fmt.Errorf("Global Orchestration ID is not configured")
 		}
 
-		orch, _ := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
-		client, _ := testAccProvider.Meta().(*Config).Client()
-		_, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "global")
+		orch := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
+		globalPath, err := getEventOrchestrationPath(context.Background(), testAccProvider.client, testAccAPIURL(), "global", orch.Primary.ID)
 
-		if err != nil {
+		if err != nil || globalPath == nil {
 			return fmt.Errorf("Global Orchestration not found for orchestration %v", orch.Primary.ID)
 		}
 
@@ -604,7 +668,7 @@ func testAccCheckPagerDutyEventOrchestrationPathGlobalAllActionsUpdateConfig(t,
 					actions {
 						route_to = "set-2"
 						priority = "P0IN2KR"
-						escalation_policy = "POLICY3"
+						escalation_policy = pagerduty_escalation_policy.foo.id
 						annotate = "Routed through a service orchestration!"
 						severity = "warning"
 						event_action = "resolve"
@@ -683,7 +747,7 @@ func testAccCheckPagerDutyEventOrchestrationPathGlobalAllActionsUpdateConfig(t,
 				actions {
 					drop_event = false
 					priority = "P0IN2KX"
-					escalation_policy = "POLICY4"
+					escalation_policy = pagerduty_escalation_policy.foo.id
 					annotate = "[UPD] Routed through an event orchestration - catch-all rule"
 					severity = "info"
 					event_action = "resolve"
@@ -764,3 +828,62 @@ func testAccCheckPagerDutyEventOrchestrationPathGlobalOneSetNoActionsConfig(t, e
 func testAccCheckPagerDutyEventOrchestrationPathGlobalResourceDeleteConfig(t, ep, s, o string) string {
 	return createBaseGlobalOrchConfig(t, ep, s, o)
 }
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalConfig(name, annotation string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + fmt.Sprintf(`
+resource "pagerduty_event_orchestration_global" "global" {
+	event_orchestration = pagerduty_event_orchestration.test.id
+
+	set {
+		id = "start"
+		rule {
+			label = "Look closer at databases"
+			condition {
+				expression = "event.summary matches part 'database'"
+			}
+			actions {
+				route_to = "step-two"
+			}
+		}
+	}
+
+	set {
+		id = "step-two"
+		rule {
+			label = "Page the database team"
+			actions {
+				annotate          = "%s"
+				escalation_policy = data.pagerduty_escalation_policy.test.id
+			}
+		}
+	}
+
+	catch_all {
+		actions {
+			suspend = 120
+		}
+	}
+}
+`, annotation)
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalUnknownEscalationPolicyConfig(name string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + `
+resource "pagerduty_event_orchestration_global" "global" {
+	event_orchestration = pagerduty_event_orchestration.test.id
+
+	set {
+		id = "start"
+		rule {
+			actions {
+				escalation_policy = "PNOTEXI"
+			}
+		}
+	}
+
+	catch_all {
+		actions {}
+	}
+}
+`
+}
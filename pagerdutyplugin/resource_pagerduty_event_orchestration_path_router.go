@@ -0,0 +1,283 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceEventOrchestrationPathRouter struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure    = (*resourceEventOrchestrationPathRouter)(nil)
+	_ resource.ResourceWithImportState  = (*resourceEventOrchestrationPathRouter)(nil)
+	_ resource.ResourceWithModifyPlan   = (*resourceEventOrchestrationPathRouter)(nil)
+	_ resource.ResourceWithUpgradeState = (*resourceEventOrchestrationPathRouter)(nil)
+)
+
+func (r *resourceEventOrchestrationPathRouter) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_event_orchestration_router"
+}
+
+func (r *resourceEventOrchestrationPathRouter) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	catchAllActions := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"route_to": schema.StringAttribute{Required: true},
+			},
+		},
+	}
+
+	resp.Schema = eventOrchestrationPathSchema("event_orchestration", map[string]schema.Attribute{}, eventOrchestrationPathRouterActionsBlock(), catchAllActions,
+		// Router can only have 'start' set
+		[]validator.List{listvalidator.SizeAtMost(1)},
+		[]validator.List{eventOrchestrationPathDynamicRoutingValidator{}},
+	)
+}
+
+// eventOrchestrationPathRouterActionsBlock is the `actions` block of the
+// rules of a Router.
+func eventOrchestrationPathRouterActionsBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"route_to": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.NoneOf("unrouted"),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"dynamic_route_to": schema.ListNestedBlock{
+					Validators: []validator.List{listvalidator.SizeAtMost(1)},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"lookup_by": schema.StringAttribute{Required: true},
+							"regex":     schema.StringAttribute{Required: true},
+							"source":    schema.StringAttribute{Required: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEventOrchestrationPathRouter) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceEventOrchestrationPathRouterModel
+	if d := req.Plan.Get(ctx, &plan); d.HasError() {
+		// Blocks not known yet, generated by dynamic blocks, are left to the apply.
+		return
+	}
+	var state resourceEventOrchestrationPathRouterModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	planEventOrchestrationPathRuleIDs(state.Sets, plan.Sets)
+
+	var references []eventOrchestrationReference
+	rulePaths, catchAllPath := eventOrchestrationPathActionsPaths(plan.Sets)
+	i := 0
+	for _, s := range plan.Sets {
+		for _, rule := range s.Rules {
+			if actions := eventOrchestrationPathBlock(rule.Actions); actions != nil {
+				references = append(references, eventOrchestrationReference{rulePaths[i].AtName("route_to"), eventOrchestrationReferenceService, actions.RouteTo})
+			}
+			i++
+		}
+	}
+	if actions := eventOrchestrationPathCatchAllActions(plan.CatchAll); actions != nil {
+		references = append(references, eventOrchestrationReference{catchAllPath.AtName("route_to"), eventOrchestrationReferenceService, actions.RouteTo})
+	}
+	checkEventOrchestrationReferences(ctx, r.client, r.config.apiURL(), references, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *resourceEventOrchestrationPathRouter) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEventOrchestrationPathRouterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, resourceEventOrchestrationPathRouterModel{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathRouter) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceEventOrchestrationPathRouterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	routerPath, err := getEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "router", id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading PagerDuty Event Orchestration Path of type router for "+id, err.Error())
+		return
+	}
+	if routerPath == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = flattenEventOrchestrationPathRouter(id, routerPath, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEventOrchestrationPathRouter) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceEventOrchestrationPathRouterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathRouter) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceEventOrchestrationPathRouterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "router", state.EventOrchestration.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEventOrchestrationPathRouter) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+// ImportState imports the router of an orchestration given its ID.
+func (r *resourceEventOrchestrationPathRouter) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("event_orchestration"), req.ID)...)
+}
+
+func (r *resourceEventOrchestrationPathRouter) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return eventOrchestrationPathStateUpgraders(resp.Schema)
+}
+
+func (r *resourceEventOrchestrationPathRouter) put(ctx context.Context, model, state resourceEventOrchestrationPathRouterModel, diags *diag.Diagnostics) resourceEventOrchestrationPathRouterModel {
+	id := model.EventOrchestration.ValueString()
+	routerPath := &eventOrchestrationPath{
+		Parent: &eventOrchestrationPathReference{ID: id},
+		Sets:   expandEventOrchestrationPathSets(model.Sets, eventOrchestrationPathRuleIDs(state.Sets, model.Sets), expandEventOrchestrationPathRouterActions),
+		CatchAll: &eventOrchestrationPathCatchAll{
+			Actions: expandEventOrchestrationPathRouterCatchAllActions(model.CatchAll),
+		},
+	}
+
+	routerPath = putEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "router", id, routerPath, diags)
+	if diags.HasError() {
+		return model
+	}
+	// The response holds the IDs of the rules just created.
+	return flattenEventOrchestrationPathRouter(id, routerPath, model)
+}
+
+type resourceEventOrchestrationPathRouterModel struct {
+	ID                 types.String                                                                            `tfsdk:"id"`
+	EventOrchestration types.String                                                                            `tfsdk:"event_orchestration"`
+	Sets               []eventOrchestrationPathSetModel[eventOrchestrationPathRouterActionsModel]              `tfsdk:"set"`
+	CatchAll           []eventOrchestrationPathCatchAllModel[eventOrchestrationPathRouterCatchAllActionsModel] `tfsdk:"catch_all"`
+}
+
+type eventOrchestrationPathRouterActionsModel struct {
+	DynamicRouteTo []eventOrchestrationPathDynamicRouteToModel `tfsdk:"dynamic_route_to"`
+	RouteTo        types.String                                `tfsdk:"route_to"`
+}
+
+type eventOrchestrationPathRouterCatchAllActionsModel struct {
+	RouteTo types.String `tfsdk:"route_to"`
+}
+
+func expandEventOrchestrationPathRouterActions(a *eventOrchestrationPathRouterActionsModel) *eventOrchestrationPathActions {
+	actions := new(eventOrchestrationPathActions)
+	if a == nil {
+		return actions
+	}
+	if len(a.DynamicRouteTo) > 0 {
+		actions.DynamicRouteTo = expandEventOrchestrationPathDynamicRouteTo(a.DynamicRouteTo)
+	} else {
+		actions.RouteTo = a.RouteTo.ValueString()
+	}
+	return actions
+}
+
+func expandEventOrchestrationPathRouterCatchAllActions(catchAll []eventOrchestrationPathCatchAllModel[eventOrchestrationPathRouterCatchAllActionsModel]) *eventOrchestrationPathActions {
+	actions := new(eventOrchestrationPathActions)
+	if a := eventOrchestrationPathCatchAllActions(catchAll); a != nil {
+		actions.RouteTo = a.RouteTo.ValueString()
+	}
+	return actions
+}
+
+func flattenEventOrchestrationPathRouter(id string, p *eventOrchestrationPath, prior resourceEventOrchestrationPathRouterModel) resourceEventOrchestrationPathRouterModel {
+	model := resourceEventOrchestrationPathRouterModel{
+		ID:                 types.StringValue(id),
+		EventOrchestration: types.StringValue(id),
+		Sets:               flattenEventOrchestrationPathSets(p.Sets, prior.Sets, flattenEventOrchestrationPathRouterActions),
+	}
+
+	actions := &eventOrchestrationPathRouterCatchAllActionsModel{RouteTo: types.StringNull()}
+	if p.CatchAll != nil && p.CatchAll.Actions != nil {
+		actions.RouteTo = types.StringValue(p.CatchAll.Actions.RouteTo)
+	}
+	model.CatchAll = newEventOrchestrationPathCatchAll(actions)
+	return model
+}
+
+func flattenEventOrchestrationPathRouterActions(a *eventOrchestrationPathActions, prior *eventOrchestrationPathRouterActionsModel) *eventOrchestrationPathRouterActionsModel {
+	if a == nil {
+		a = new(eventOrchestrationPathActions)
+	}
+	if prior == nil {
+		prior = new(eventOrchestrationPathRouterActionsModel)
+	}
+
+	if a.DynamicRouteTo != nil {
+		return &eventOrchestrationPathRouterActionsModel{
+			DynamicRouteTo: flattenEventOrchestrationPathDynamicRouteTo(a.DynamicRouteTo),
+			RouteTo:        types.StringNull(),
+		}
+	}
+	return &eventOrchestrationPathRouterActionsModel{
+		DynamicRouteTo: flattenEventOrchestrationPathDynamicRouteTo(nil),
+		RouteTo:        eventOrchestrationPathString(a.RouteTo, prior.RouteTo),
+	}
+}
@@ -3,13 +3,16 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"log"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/PagerDuty/go-pagerduty"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -19,30 +22,96 @@ func init() {
 	})
 }
 
+func testSweepEventOrchestration(region string) error {
+	ctx := context.Background()
+	resp, err := testAccProvider.client.ListOrchestrationsWithContext(ctx, pagerduty.ListOrchestrationsOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, orchestration := range resp.Orchestrations {
+		if strings.HasPrefix(orchestration.Name, "tf-orchestration-") {
+			log.Printf("Destroying Event Orchestration %s (%s)", orchestration.Name, orchestration.ID)
+			if err := testAccProvider.client.DeleteOrchestrationWithContext(ctx, orchestration.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestMatchEventOrchestrationPathRuleIDs(t *testing.T) {
+	type rule = eventOrchestrationPathRuleIdentity
+	old := []rule{
+		{id: "1", label: "disk"},
+		{id: "2", label: "cpu"},
+		{id: "3", key: "db", label: "database"},
+		{id: "4"},
+	}
+
+	cases := []struct {
+		name string
+		new  []rule
+		want string
+	}{
+		{
+			name: "insertion at the top",
+			new:  []rule{{label: "memory"}, {label: "disk"}, {label: "cpu"}, {key: "db", label: "database"}, {}},
+			want: ",1,2,3,4",
+		},
+		{
+			name: "reorder",
+			new:  []rule{{key: "db", label: "Databases"}, {label: "cpu"}, {label: "disk"}, {}},
+			want: "3,2,1,4",
+		},
+		{
+			name: "key added to a rule",
+			new:  []rule{{key: "disk", label: "disk"}, {label: "cpu"}, {key: "db"}, {}},
+			want: "1,2,3,4",
+		},
+		{
+			name: "removal",
+			new:  []rule{{label: "cpu"}, {key: "db"}, {}},
+			want: "2,3,4",
+		},
+		{
+			name: "duplicate labels",
+			new:  []rule{{label: "cpu"}, {label: "cpu"}, {key: "db"}, {}},
+			want: "2,,3,4",
+		},
+	}
+	for _, c := range cases {
+		if got := strings.Join(matchEventOrchestrationPathRuleIDs(old, c.new), ","); got != c.want {
+			t.Errorf("%s: expected rule IDs %q, got %q", c.name, c.want, got)
+		}
+	}
+}
+
 func TestAccPagerDutyEventOrchestrationPathRouter_Basic(t *testing.T) {
 	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
 	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
 
-	dynamicRouteToByNameInput := &pagerduty.EventOrchestrationPathDynamicRouteTo{
+	dynamicRouteToByNameInput := &eventOrchestrationPathDynamicRouteTo{
 		LookupBy: "service_name",
 		Regex:    ".*",
 		Source:   "event.custom_details.pd_service_name",
 	}
-	dynamicRouteToByIDInput := &pagerduty.EventOrchestrationPathDynamicRouteTo{
+	dynamicRouteToByIDInput := &eventOrchestrationPathDynamicRouteTo{
 		LookupBy: "service_id",
 		Regex:    "ID:(.*)",
 		Source:   "event.custom_details.pd_service_id",
 	}
-	invalidDynamicRouteToPlacementMessage := "Invalid Dynamic Routing rule configuration:\n- A Router can have at most one Dynamic Routing rule; Rules with the dynamic_route_to action found at indexes: 1, 2\n- The Dynamic Routing rule must be the first rule in a Router"
-	invalidDynamicRouteToConfigMessage := "Invalid Dynamic Routing rule configuration:\n- Dynamic Routing rules cannot have conditions\n- Dynamic Routing rules cannot have the `route_to` action"
+	invalidDynamicRouteToPlacementMessage := "- A Router can have at most one Dynamic Routing rule; Rules with the dynamic_route_to action found at indexes: 1, 2\n- The Dynamic Routing rule must be the first rule in a Router"
+	invalidDynamicRouteToConfigMessage := "- Dynamic Routing rules cannot have conditions\n- Dynamic Routing rules cannot have the `route_to` action"
 	invalidEmptyActionsMessage := "at least one of 'route_to' or 'dynamic_route_to' must be specified in actions"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationRouterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathRouterDestroy,
 		Steps: []resource.TestStep{
 			// Invalid Dynamic Routing rule config for a new resource: multiple Dynamic Routing rules, Dynamic Routing rule not the first rule in the Router:
 			{
@@ -194,9 +263,9 @@ func TestAccPagerDutyEventOrchestrationPathRouter_EnableRoutingRule(t *testing.T
 	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationRouterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathRouterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationRouterEnableRoutingRuleConfig(team, escalationPolicy, service, orchestration),
@@ -229,9 +298,9 @@ func TestAccPagerDutyEventOrchestrationPathRouter_NilConditionHandling(t *testin
 	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationRouterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathRouterDestroy,
 		Steps: []resource.TestStep{
 			// Test router with rule without explicit conditions (potential nil condition scenario)
 			{
@@ -260,20 +329,216 @@ func TestAccPagerDutyEventOrchestrationPathRouter_NilConditionHandling(t *testin
 	})
 }
 
-func testAccCheckPagerDutyEventOrchestrationRouterDestroy(s *terraform.State) error {
-	client, _ := testAccProvider.Meta().(*Config).Client()
+func TestAccPagerDutyEventOrchestrationPathRouter_RuleKeys(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_router.router"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathRouterConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRouterRules(resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "event_orchestration", "pagerduty_event_orchestration.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "set.0.rule.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "set.0.rule.0.actions.0.route_to", "pagerduty_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.route_to", "unrouted"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathRouterDynamicRouteToConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathRouterRules(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.dynamic_route_to.0.lookup_by", "service_id"),
+					resource.TestCheckNoResourceAttr(resourceName, "set.0.rule.0.actions.0.route_to"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Keys only identify rules in the configuration.
+				ImportStateVerifyIgnore: []string{"set.0.rule.0.key", "set.0.rule.1.key"},
+			},
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathRouterUnroutedRuleConfig(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be none of`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEventOrchestrationPathRouter_SDKv2Compatibility(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_router.router"
+	config := testAccCheckPagerDutyEventOrchestrationPathRouterConfig(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check:             resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.route_to", "unrouted"),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.route_to", "unrouted"),
+					resource.TestCheckResourceAttrSet(resourceName, "set.0.rule.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathRouterDestroy(s *terraform.State) error {
 	for _, r := range s.RootModule().Resources {
-		if r.Type != "pagerduty_event_orchestration_path_router" {
+		if r.Type != "pagerduty_event_orchestration_router" {
 			continue
 		}
+		router, err := testAccProvider.client.GetOrchestrationRouterWithContext(context.Background(), r.Primary.ID, nil)
+		if err != nil {
+			continue
+		}
+		for _, set := range router.Sets {
+			if len(set.Rules) > 0 {
+				return fmt.Errorf("Event Orchestration Router %s still has rules", r.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathRouterRules(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		router, err := testAccProvider.client.GetOrchestrationRouterWithContext(context.Background(), rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		if len(router.Sets) != 1 || len(router.Sets[0].Rules) != count {
+			return fmt.Errorf("Expected %d rules in the Router of %s", count, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccPagerDutyEventOrchestrationPathBaseConfig is an orchestration and a
+// service the paths route events to.
+func testAccPagerDutyEventOrchestrationPathBaseConfig(name string) string {
+	return fmt.Sprintf(`
+data "pagerduty_escalation_policy" "test" {
+	name = "Default"
+}
 
-		orch, _ := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
+resource "pagerduty_service" "test" {
+	name = "%[1]s"
+	escalation_policy = data.pagerduty_escalation_policy.test.id
+}
+
+resource "pagerduty_event_orchestration" "test" {
+	name = "%[1]s"
+}
+`, name)
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathRouterConfig(name string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + `
+resource "pagerduty_event_orchestration_router" "router" {
+	event_orchestration = pagerduty_event_orchestration.test.id
+
+	set {
+		id = "start"
+		rule {
+			label = "Route disk alerts"
+			condition {
+				expression = "event.summary matches part 'disk'"
+			}
+			actions {
+				route_to = pagerduty_service.test.id
+			}
+		}
+	}
 
-		if _, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "router"); err == nil {
-			return fmt.Errorf("Event Orchestration Path still exists")
+	catch_all {
+		actions {
+			route_to = "unrouted"
 		}
 	}
-	return nil
+}
+`
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathRouterDynamicRouteToConfig(name string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + `
+resource "pagerduty_event_orchestration_router" "router" {
+	event_orchestration = pagerduty_event_orchestration.test.id
+
+	set {
+		id = "start"
+		rule {
+			key   = "dynamic"
+			label = "Dynamic routing"
+			actions {
+				dynamic_route_to {
+					lookup_by = "service_id"
+					source    = "event.custom_details.pd_service_id"
+					regex     = "(.*)"
+				}
+			}
+		}
+		rule {
+			key   = "disk"
+			label = "Route disk alerts"
+			condition {
+				expression = "event.summary matches part 'disk'"
+			}
+			actions {
+				route_to = pagerduty_service.test.id
+			}
+		}
+	}
+
+	catch_all {
+		actions {
+			route_to = "unrouted"
+		}
+	}
+}
+`
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathRouterUnroutedRuleConfig(name string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + `
+resource "pagerduty_event_orchestration_router" "router" {
+	event_orchestration = pagerduty_event_orchestration.test.id
+
+	set {
+		id = "start"
+		rule {
+			actions {
+				route_to = "unrouted"
+			}
+		}
+	}
+
+	catch_all {
+		actions {
+			route_to = "unrouted"
+		}
+	}
+}
+`
 }
 
 func testAccCheckPagerDutyEventOrchestrationRouterExists(rn string) resource.TestCheckFunc {
@@ -286,9 +551,8 @@ func testAccCheckPagerDutyEventOrchestrationRouterExists(rn string) resource.Tes
 			return fmt.Errorf("No Event Orchestration Router is set")
 		}
 
-		orch, _ := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
-		client, _ := testAccProvider.Meta().(*Config).Client()
-		_, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "router")
+		orch := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
+		_, err := testAccProvider.client.GetOrchestrationRouterWithContext(context.Background(), orch.Primary.ID, nil)
 
 		if err != nil {
 			return fmt.Errorf("Orchestration Path type not found: %v for orchestration %v", "router", orch.Primary.ID)
@@ -486,7 +750,7 @@ func testAccCheckPagerDutyEventOrchestrationRouterConfig(t, ep, s, o string) str
 	`)
 }
 
-func testAccCheckPagerDutyEventOrchestrationRouterDynamicRouteToConfig(t, ep, s, o string, dynamicRouteToByNameInput *pagerduty.EventOrchestrationPathDynamicRouteTo) string {
+func testAccCheckPagerDutyEventOrchestrationRouterDynamicRouteToConfig(t, ep, s, o string, dynamicRouteToByNameInput *eventOrchestrationPathDynamicRouteTo) string {
 	routerConfig := fmt.Sprintf(
 		`resource "pagerduty_event_orchestration_router" "router" {
 			event_orchestration = pagerduty_event_orchestration.orch.id
@@ -800,7 +1064,7 @@ func testAccCheckPagerDutyEventOrchestrationRouterPathRouteToMatch(router, servi
 	}
 }
 
-func testAccCheckPagerDutyEventOrchestrationRouterPathDynamicRouteToMatch(router string, expectedDynamicRouteTo *pagerduty.EventOrchestrationPathDynamicRouteTo) resource.TestCheckFunc {
+func testAccCheckPagerDutyEventOrchestrationRouterPathDynamicRouteToMatch(router string, expectedDynamicRouteTo *eventOrchestrationPathDynamicRouteTo) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		r, rOk := s.RootModule().Resources[router]
 		if !rOk {
@@ -0,0 +1,407 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type resourceEventOrchestrationPathService struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure    = (*resourceEventOrchestrationPathService)(nil)
+	_ resource.ResourceWithImportState  = (*resourceEventOrchestrationPathService)(nil)
+	_ resource.ResourceWithModifyPlan   = (*resourceEventOrchestrationPathService)(nil)
+	_ resource.ResourceWithUpgradeState = (*resourceEventOrchestrationPathService)(nil)
+)
+
+func (r *resourceEventOrchestrationPathService) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_event_orchestration_service"
+}
+
+func (r *resourceEventOrchestrationPathService) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"enable_event_orchestration_for_service": schema.BoolAttribute{
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		},
+	}
+
+	resp.Schema = eventOrchestrationPathSchema("service", attributes,
+		eventOrchestrationPathServiceActionsBlock(""),
+		eventOrchestrationPathServiceActionsBlock("The 'route_to' attribute is no longer supported for catch-all rules."),
+		[]validator.List{eventOrchestrationPathSetsValidator{}},
+		nil,
+	)
+}
+
+// eventOrchestrationPathServiceActionsBlock is the `actions` block of the
+// rules and of the catch-all, whose `route_to` is deprecated, of the path.
+func eventOrchestrationPathServiceActionsBlock(routeToDeprecation string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"route_to": schema.StringAttribute{
+					Optional:           true,
+					DeprecationMessage: routeToDeprecation,
+				},
+				"suppress":          schema.BoolAttribute{Optional: true},
+				"suspend":           schema.Int64Attribute{Optional: true},
+				"priority":          schema.StringAttribute{Optional: true},
+				"annotate":          schema.StringAttribute{Optional: true},
+				"severity":          eventOrchestrationPathSeverityAttribute(),
+				"event_action":      eventOrchestrationPathEventActionAttribute(),
+				"escalation_policy": schema.StringAttribute{Optional: true},
+			},
+			Blocks: map[string]schema.Block{
+				"pagerduty_automation_action":  eventOrchestrationPathPagerdutyAutomationActionBlock(),
+				"automation_action":            eventOrchestrationPathAutomationActionBlock(),
+				"variable":                     eventOrchestrationPathVariableBlock(),
+				"extraction":                   eventOrchestrationPathExtractionBlock(),
+				"incident_custom_field_update": eventOrchestrationPathIncidentCustomFieldUpdateBlock(),
+			},
+			Validators: []validator.Object{eventOrchestrationPathTemplateValidator{}},
+		},
+	}
+}
+
+func (r *resourceEventOrchestrationPathService) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceEventOrchestrationPathServiceModel
+	if d := req.Plan.Get(ctx, &plan); d.HasError() {
+		// Blocks not known yet, generated by dynamic blocks, are left to the apply.
+		return
+	}
+	var state resourceEventOrchestrationPathServiceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	planEventOrchestrationPathRuleIDs(state.Sets, plan.Sets)
+
+	rulePaths, catchAllPath := eventOrchestrationPathActionsPaths(plan.Sets)
+	var allActions []*eventOrchestrationPathServiceActionsModel
+	for _, s := range plan.Sets {
+		for _, rule := range s.Rules {
+			allActions = append(allActions, eventOrchestrationPathBlock(rule.Actions))
+		}
+	}
+	allActions = append(allActions, eventOrchestrationPathCatchAllActions(plan.CatchAll))
+	paths := append(rulePaths, catchAllPath)
+
+	var references []eventOrchestrationReference
+	for i, a := range allActions {
+		if a == nil {
+			continue
+		}
+		references = append(references, eventOrchestrationReference{paths[i].AtName("escalation_policy"), eventOrchestrationReferenceEscalationPolicy, a.EscalationPolicy})
+		for j, action := range a.PagerdutyAutomationAction {
+			references = append(references, eventOrchestrationReference{paths[i].AtName("pagerduty_automation_action").AtListIndex(j).AtName("action_id"), eventOrchestrationReferenceAutomationAction, action.ActionID})
+		}
+	}
+	checkEventOrchestrationReferences(ctx, r.client, r.config.apiURL(), references, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *resourceEventOrchestrationPathService) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEventOrchestrationPathServiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, resourceEventOrchestrationPathServiceModel{}, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathService) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceEventOrchestrationPathServiceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := state.Service.ValueString()
+	if serviceID == "" {
+		serviceID = state.ID.ValueString()
+	}
+	servicePath, err := getEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "service", serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading PagerDuty Event Orchestration Path of type service for "+serviceID, err.Error())
+		return
+	}
+	if servicePath == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	active, err := r.getActiveStatus(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading PagerDuty Event Orchestration Path Service Active Status for "+serviceID, err.Error())
+		return
+	}
+
+	state = flattenEventOrchestrationPathService(serviceID, servicePath, state)
+	state.EnableEventOrchestrationForService = types.BoolValue(active)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEventOrchestrationPathService) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceEventOrchestrationPathServiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, state, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathService) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceEventOrchestrationPathServiceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "service", state.Service.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEventOrchestrationPathService) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+// ImportState imports the path of a service given its ID.
+func (r *resourceEventOrchestrationPathService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), req.ID)...)
+}
+
+func (r *resourceEventOrchestrationPathService) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return eventOrchestrationPathStateUpgraders(resp.Schema)
+}
+
+func (r *resourceEventOrchestrationPathService) put(ctx context.Context, model, state resourceEventOrchestrationPathServiceModel, isNew bool, diags *diag.Diagnostics) resourceEventOrchestrationPathServiceModel {
+	serviceID := model.Service.ValueString()
+	servicePath := &eventOrchestrationPath{
+		Parent: &eventOrchestrationPathReference{ID: serviceID},
+		Sets:   expandEventOrchestrationPathSets(model.Sets, eventOrchestrationPathRuleIDs(state.Sets, model.Sets), expandEventOrchestrationPathServiceActions),
+		CatchAll: &eventOrchestrationPathCatchAll{
+			Actions: expandEventOrchestrationPathServiceActions(eventOrchestrationPathCatchAllActions(model.CatchAll)),
+		},
+	}
+
+	servicePath = putEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "service", serviceID, servicePath, diags)
+	if diags.HasError() {
+		return model
+	}
+	enable := model.EnableEventOrchestrationForService
+	// The response holds the IDs of the rules just created.
+	model = flattenEventOrchestrationPathService(serviceID, servicePath, model)
+
+	// The status is only updated when set in the configuration of a new
+	// resource, or changed.
+	switch {
+	case !enable.IsUnknown() && (isNew || !enable.Equal(state.EnableEventOrchestrationForService)):
+		r.updateActiveStatus(ctx, serviceID, enable.ValueBool(), diags)
+		model.EnableEventOrchestrationForService = enable
+	case enable.IsUnknown():
+		active, err := r.getActiveStatus(ctx, serviceID)
+		if err != nil {
+			diags.AddError("Error reading PagerDuty Event Orchestration Path Service Active Status for "+serviceID, err.Error())
+			return model
+		}
+		model.EnableEventOrchestrationForService = types.BoolValue(active)
+	default:
+		model.EnableEventOrchestrationForService = enable
+	}
+	return model
+}
+
+type eventOrchestrationPathServiceActiveStatus struct {
+	Active bool `json:"active"`
+}
+
+// getActiveStatus returns whether Event Orchestration is enabled for a
+// service. The endpoint answers 410 once all the services of the account use
+// Event Orchestration.
+func (r *resourceEventOrchestrationPathService) getActiveStatus(ctx context.Context, serviceID string) (bool, error) {
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Path Service Active Status for service: %s", serviceID)
+
+	var active bool
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var status eventOrchestrationPathServiceActiveStatus
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodGet, fmt.Sprintf("/event_orchestrations/services/%s/active", serviceID), nil, &status)
+		if err != nil {
+			// It should not retry request to the status endpoint after it
+			// starts to return 410 (Gone).
+			if isGoneError(err) {
+				active = true
+				return nil
+			}
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		active = status.Active
+		return nil
+	})
+	return active, err
+}
+
+func (r *resourceEventOrchestrationPathService) updateActiveStatus(ctx context.Context, serviceID string, active bool, diags *diag.Diagnostics) {
+	log.Printf("[INFO] Updating PagerDuty Event Orchestration Path Service Active Status for service: %s", serviceID)
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var status eventOrchestrationPathServiceActiveStatus
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPut, fmt.Sprintf("/event_orchestrations/services/%s/active", serviceID), eventOrchestrationPathServiceActiveStatus{Active: active}, &status)
+		if err != nil {
+			if isGoneError(err) {
+				return nil
+			}
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		if status.Active != active {
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(fmt.Errorf("inconsistent result received when trying to update event orchestration active status for service %q", serviceID))
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError("Error updating PagerDuty Event Orchestration Path Service Active Status for "+serviceID, err.Error())
+	}
+}
+
+type resourceEventOrchestrationPathServiceModel struct {
+	ID                                 types.String                                                                     `tfsdk:"id"`
+	Service                            types.String                                                                     `tfsdk:"service"`
+	EnableEventOrchestrationForService types.Bool                                                                       `tfsdk:"enable_event_orchestration_for_service"`
+	Sets                               []eventOrchestrationPathSetModel[eventOrchestrationPathServiceActionsModel]      `tfsdk:"set"`
+	CatchAll                           []eventOrchestrationPathCatchAllModel[eventOrchestrationPathServiceActionsModel] `tfsdk:"catch_all"`
+}
+
+type eventOrchestrationPathServiceActionsModel struct {
+	RouteTo                    types.String                                           `tfsdk:"route_to"`
+	Suppress                   types.Bool                                             `tfsdk:"suppress"`
+	Suspend                    types.Int64                                            `tfsdk:"suspend"`
+	Priority                   types.String                                           `tfsdk:"priority"`
+	Annotate                   types.String                                           `tfsdk:"annotate"`
+	PagerdutyAutomationAction  []eventOrchestrationPathPagerdutyAutomationActionModel `tfsdk:"pagerduty_automation_action"`
+	AutomationAction           []eventOrchestrationPathAutomationActionModel          `tfsdk:"automation_action"`
+	Severity                   types.String                                           `tfsdk:"severity"`
+	EventAction                types.String                                           `tfsdk:"event_action"`
+	Variables                  []eventOrchestrationPathVariableModel                  `tfsdk:"variable"`
+	Extractions                []eventOrchestrationPathExtractionModel                `tfsdk:"extraction"`
+	IncidentCustomFieldUpdates []eventOrchestrationPathIncidentCustomFieldUpdateModel `tfsdk:"incident_custom_field_update"`
+	EscalationPolicy           types.String                                           `tfsdk:"escalation_policy"`
+}
+
+func expandEventOrchestrationPathServiceActions(a *eventOrchestrationPathServiceActionsModel) *eventOrchestrationPathActions {
+	actions := &eventOrchestrationPathActions{
+		PagerdutyAutomationActions: []*eventOrchestrationPathPagerdutyAutomationAction{},
+		AutomationActions:          []*eventOrchestrationPathAutomationAction{},
+		Variables:                  []*eventOrchestrationPathVariable{},
+		Extractions:                []*eventOrchestrationPathExtraction{},
+		IncidentCustomFieldUpdates: []*eventOrchestrationPathIncidentCustomFieldUpdate{},
+	}
+	if a == nil {
+		return actions
+	}
+
+	actions.RouteTo = a.RouteTo.ValueString()
+	actions.Suppress = a.Suppress.ValueBool()
+	actions.Suspend = util.IntTypeToIntPtr(int(a.Suspend.ValueInt64()))
+	actions.Priority = a.Priority.ValueString()
+	actions.Annotate = a.Annotate.ValueString()
+	actions.PagerdutyAutomationActions = expandEventOrchestrationPathPagerdutyAutomationActions(a.PagerdutyAutomationAction)
+	actions.AutomationActions = expandEventOrchestrationPathAutomationActions(a.AutomationAction)
+	actions.Severity = a.Severity.ValueString()
+	actions.EventAction = a.EventAction.ValueString()
+	actions.Variables = expandEventOrchestrationPathVariables(a.Variables)
+	actions.Extractions = expandEventOrchestrationPathExtractions(a.Extractions)
+	actions.IncidentCustomFieldUpdates = expandEventOrchestrationPathIncidentCustomFieldUpdates(a.IncidentCustomFieldUpdates)
+	actions.EscalationPolicy = util.StringTypeToStringPtr(a.EscalationPolicy.ValueString())
+	return actions
+}
+
+func flattenEventOrchestrationPathService(serviceID string, p *eventOrchestrationPath, prior resourceEventOrchestrationPathServiceModel) resourceEventOrchestrationPathServiceModel {
+	model := resourceEventOrchestrationPathServiceModel{
+		ID:                                 types.StringValue(serviceID),
+		Service:                            types.StringValue(serviceID),
+		EnableEventOrchestrationForService: prior.EnableEventOrchestrationForService,
+		Sets:                               flattenEventOrchestrationPathSets(p.Sets, prior.Sets, flattenEventOrchestrationPathServiceActions),
+	}
+
+	var actions *eventOrchestrationPathActions
+	if p.CatchAll != nil {
+		actions = p.CatchAll.Actions
+	}
+	model.CatchAll = newEventOrchestrationPathCatchAll(flattenEventOrchestrationPathServiceActions(actions, eventOrchestrationPathCatchAllActions(prior.CatchAll)))
+	return model
+}
+
+func flattenEventOrchestrationPathServiceActions(a *eventOrchestrationPathActions, prior *eventOrchestrationPathServiceActionsModel) *eventOrchestrationPathServiceActionsModel {
+	if a == nil {
+		a = new(eventOrchestrationPathActions)
+	}
+	if prior == nil {
+		prior = new(eventOrchestrationPathServiceActionsModel)
+	}
+
+	return &eventOrchestrationPathServiceActionsModel{
+		RouteTo:                    eventOrchestrationPathString(a.RouteTo, prior.RouteTo),
+		Suppress:                   eventOrchestrationPathBool(a.Suppress, prior.Suppress),
+		Suspend:                    eventOrchestrationPathInt64(a.Suspend, prior.Suspend),
+		Priority:                   eventOrchestrationPathString(a.Priority, prior.Priority),
+		Annotate:                   eventOrchestrationPathString(a.Annotate, prior.Annotate),
+		PagerdutyAutomationAction:  flattenEventOrchestrationPathPagerdutyAutomationActions(a.PagerdutyAutomationActions, prior.PagerdutyAutomationAction),
+		AutomationAction:           flattenEventOrchestrationPathAutomationActions(a.AutomationActions, prior.AutomationAction),
+		Severity:                   eventOrchestrationPathString(a.Severity, prior.Severity),
+		EventAction:                eventOrchestrationPathString(a.EventAction, prior.EventAction),
+		Variables:                  flattenEventOrchestrationPathVariables(a.Variables),
+		Extractions:                flattenEventOrchestrationPathExtractions(a.Extractions, prior.Extractions),
+		IncidentCustomFieldUpdates: flattenEventOrchestrationPathIncidentCustomFieldUpdates(a.IncidentCustomFieldUpdates),
+		EscalationPolicy:           eventOrchestrationPathStringPtr(a.EscalationPolicy, prior.EscalationPolicy),
+	}
+}
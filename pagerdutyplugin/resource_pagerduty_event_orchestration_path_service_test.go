@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -35,9 +36,9 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationServicePathDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathServiceDefaultConfig(escalationPolicy, service),
@@ -74,21 +75,35 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 					escalationPolicy, service, invalidExtractionRegexTemplateNilConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: regex and template cannot both be null"),
+				ExpectError: regexp.MustCompile("regex and template cannot both be null"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
 					escalationPolicy, service, invalidExtractionRegexTemplateValConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: regex and template cannot both have values"),
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
 					escalationPolicy, service, invalidExtractionRegexNilSourceConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: source can't be blank"),
+				ExpectError: regexp.MustCompile("source can't be blank"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
+					escalationPolicy, service, invalidExtractionRegexSyntaxConfig(), "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("error parsing regexp"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
+					escalationPolicy, service, invalidExtractionTemplateVariableConfig(), "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`{{variables.host}} references the variable "host"`),
 			},
 			// Providing invalid extractions attributes for the catch_all rule
 			{
@@ -96,21 +111,28 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 					escalationPolicy, service, "", invalidExtractionRegexTemplateNilConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: regex and template cannot both be null"),
+				ExpectError: regexp.MustCompile("regex and template cannot both be null"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
 					escalationPolicy, service, "", invalidExtractionRegexTemplateValConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: regex and template cannot both have values"),
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
 					escalationPolicy, service, "", invalidExtractionRegexNilSourceConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: source can't be blank"),
+				ExpectError: regexp.MustCompile("source can't be blank"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
+					escalationPolicy, service, "", invalidExtractionRegexSyntaxConfig(),
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("error parsing regexp"),
 			},
 			// Adding/updating/deleting all actions
 			{
@@ -135,8 +157,8 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 							resource.TestCheckResourceAttrSet(resourceName, "set.0.rule.0.id"),
 							resource.TestCheckResourceAttrSet(resourceName, "set.1.rule.0.id"),
 							resource.TestCheckResourceAttrSet(resourceName, "set.1.rule.1.id"),
-							resource.TestCheckResourceAttr(
-								resourceName, "set.0.rule.0.actions.0.escalation_policy", "POLICY3",
+							resource.TestCheckResourceAttrPair(
+								resourceName, "set.0.rule.0.actions.0.escalation_policy", "pagerduty_escalation_policy.foo", "id",
 							),
 						}...,
 					)...,
@@ -177,7 +199,8 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					append(
 						baseChecks,
-						resource.TestCheckNoResourceAttr(resourceName, "enable_event_orchestration_for_service"),
+						// The status is read from the API when not configured.
+						resource.TestCheckResourceAttrSet(resourceName, "enable_event_orchestration_for_service"),
 					)...,
 				),
 			},
@@ -238,22 +261,115 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 	})
 }
 
-func testAccCheckPagerDutyEventOrchestrationServicePathDestroy(s *terraform.State) error {
-	client, _ := testAccProvider.Meta().(*Config).Client()
+func TestAccPagerDutyEventOrchestrationPathService_Variables(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_service.service"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceConfig(name, "critical"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceRules(resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "service", "pagerduty_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enable_event_orchestration_for_service", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "set.0.rule.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.route_to", "step-two"),
+					resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.severity", "critical"),
+					resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.extraction.0.template", "{{variables.host}}"),
+					resource.TestCheckNoResourceAttr(resourceName, "set.1.rule.0.actions.0.suspend"),
+					resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.suppress", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceConfig(name, "warning"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceRules(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.severity", "warning"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathServiceUnknownSetConfig(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`no set with the ID "nowhere"`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEventOrchestrationPathService_SDKv2Compatibility(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_service.service"
+	config := testAccCheckPagerDutyEventOrchestrationPathServiceConfig(name, "critical")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check:             resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.severity", "critical"),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "set.1.rule.0.actions.0.severity", "critical"),
+					resource.TestCheckResourceAttr(resourceName, "catch_all.0.actions.0.suppress", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathServiceDestroy(s *terraform.State) error {
 	for _, r := range s.RootModule().Resources {
-		if r.Type != "pagerduty_event_orchestration_path_service" {
+		if r.Type != "pagerduty_event_orchestration_service" {
 			continue
 		}
-
-		srv := s.RootModule().Resources["pagerduty_service.bar"]
-
-		if _, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), srv.Primary.ID, "service"); err == nil {
-			return fmt.Errorf("Event Orchestration Service Path still exists")
+		servicePath, err := testAccProvider.client.GetServiceOrchestrationWithContext(context.Background(), r.Primary.ID, nil)
+		if err != nil {
+			continue
+		}
+		for _, set := range servicePath.Sets {
+			if len(set.Rules) > 0 {
+				return fmt.Errorf("Event Orchestration Service Path %s still has rules", r.Primary.ID)
+			}
 		}
 	}
 	return nil
 }
 
+func testAccCheckPagerDutyEventOrchestrationPathServiceRules(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		servicePath, err := testAccProvider.client.GetServiceOrchestrationWithContext(context.Background(), rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		rules := 0
+		for _, set := range servicePath.Sets {
+			rules += len(set.Rules)
+		}
+		if rules != count {
+			return fmt.Errorf("Expected %d rules in the Service Path of %s, got %d", count, rs.Primary.ID, rules)
+		}
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyEventOrchestrationPathServiceExists(rn string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		orch, ok := s.RootModule().Resources[rn]
@@ -264,8 +380,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceExists(rn string) resourc
 			return fmt.Errorf("No Event Orchestration Service ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-		found, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "service")
+		found, err := testAccProvider.client.GetServiceOrchestrationWithContext(context.Background(), orch.Primary.ID, nil)
 		if err != nil {
 			return err
 		}
@@ -432,6 +547,20 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAutomationActionsConfig(e
 	`)
 }
 
+// Automation Actions referenced by rules must exist.
+func createServicePathAutomationActionConfig(s string) string {
+	return fmt.Sprintf(`
+	resource "pagerduty_automation_actions_action" "foo" {
+		name        = "%s"
+		action_type = "script"
+		action_data_reference {
+			script             = "java --version"
+			invocation_command = "/bin/bash"
+		}
+	}
+	`, s)
+}
+
 func testAccCheckPagerDutyEventOrchestrationPathServiceAutomationActionsParamsUpdateConfig(ep, s string) string {
 	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
 		`resource "pagerduty_event_orchestration_service" "serviceA" {
@@ -545,7 +674,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
 }
 
 func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s string) string {
-	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
+	return fmt.Sprintf("%s%s%s", createBaseServicePathConfig(ep, s), createServicePathAutomationActionConfig(s),
 		`resource "pagerduty_event_orchestration_service" "serviceA" {
 			service = pagerduty_service.bar.id
 
@@ -565,7 +694,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s st
 						escalation_policy = pagerduty_escalation_policy.foo.id
 						annotate = "Routed through an event orchestration"
 						pagerduty_automation_action {
-							action_id = "01CSB5SMOKCKVRI5GN0LJG7SMB"
+							action_id = pagerduty_automation_actions_action.foo.id
 							trigger_types = ["alert_suppressed"]
 						}
 						severity = "critical"
@@ -624,7 +753,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s st
 					escalation_policy = pagerduty_escalation_policy.foo.id
 					annotate = "Routed through an event orchestration - catch-all rule"
 					pagerduty_automation_action {
-						action_id = "01CSB5SMOKCKVRI5GN0LJG7SMC"
+						action_id = pagerduty_automation_actions_action.foo.id
 						trigger_types = ["alert_suspended"]
 					}
 					severity = "warning"
@@ -657,7 +786,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s st
 }
 
 func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsUpdateConfig(ep, s string) string {
-	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
+	return fmt.Sprintf("%s%s%s", createBaseServicePathConfig(ep, s), createServicePathAutomationActionConfig(s),
 		`resource "pagerduty_event_orchestration_service" "serviceA" {
 			service = pagerduty_service.bar.id
 
@@ -671,10 +800,10 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsUpdateConfig(ep
 					actions {
 						route_to = "set-2"
 						priority = "P0IN2KR"
-						escalation_policy = "POLICY3"
+						escalation_policy = pagerduty_escalation_policy.foo.id
 						annotate = "Routed through a service orchestration!"
 						pagerduty_automation_action {
-							action_id = "01CSB5SMOKCKVRI5GN0LJG7SMBUPDATED"
+							action_id = pagerduty_automation_actions_action.foo.id
 							trigger_types = ["alert_suspended"]
 						}
 						severity = "warning"
@@ -744,10 +873,10 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsUpdateConfig(ep
 				actions {
 					suspend = 360
 					priority = "P0IN2KX"
-					escalation_policy = "POLICY4"
+					escalation_policy = pagerduty_escalation_policy.foo.id
 					annotate = "[UPD] Routed through an event orchestration - catch-all rule"
 					pagerduty_automation_action {
-						action_id = "01CSB5SMOKCKVRI5GN0LJG7SMD"
+						action_id = pagerduty_automation_actions_action.foo.id
 						trigger_types = ["alert_triggered"]
 					}
 					severity = "info"
@@ -859,3 +988,119 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceEnableEOForServiceDisable
 		}
 	`)
 }
+
+func testAccCheckPagerDutyEventOrchestrationPathServiceConfig(name, severity string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + fmt.Sprintf(`
+resource "pagerduty_event_orchestration_service" "service" {
+	service                                = pagerduty_service.test.id
+	enable_event_orchestration_for_service = true
+
+	set {
+		id = "start"
+		rule {
+			label = "Look closer at timeouts"
+			condition {
+				expression = "event.summary matches part 'timeout'"
+			}
+			actions {
+				route_to = "step-two"
+			}
+		}
+	}
+
+	set {
+		id = "step-two"
+		rule {
+			label = "Name the host"
+			actions {
+				severity = "%s"
+				variable {
+					name  = "host"
+					path  = "event.summary"
+					type  = "regex"
+					value = "on host (.*)"
+				}
+				extraction {
+					target   = "event.custom_details.host"
+					template = "{{variables.host}}"
+				}
+			}
+		}
+	}
+
+	catch_all {
+		actions {
+			suppress = true
+		}
+	}
+}
+`, severity)
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathServiceUnknownSetConfig(name string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + `
+resource "pagerduty_event_orchestration_service" "service" {
+	service = pagerduty_service.test.id
+
+	set {
+		id = "start"
+		rule {
+			actions {
+				route_to = "nowhere"
+			}
+		}
+	}
+
+	catch_all {
+		actions {}
+	}
+}
+`
+}
+
+func invalidExtractionRegexTemplateNilConfig() string {
+	return `
+		extraction {
+			target = "event.summary"
+		}`
+}
+
+func invalidExtractionRegexTemplateValConfig() string {
+	return `
+		extraction {
+			regex = ".*"
+			template = "hi"
+			target = "event.summary"
+		}`
+}
+
+func invalidExtractionRegexNilSourceConfig() string {
+	return `
+		extraction {
+			regex = ".*"
+			target = "event.summary"
+		}`
+}
+
+func invalidExtractionRegexSyntaxConfig() string {
+	return `
+		extraction {
+			regex = "Source host: (.*"
+			source = "event.source"
+			target = "event.summary"
+		}`
+}
+
+func invalidExtractionTemplateVariableConfig() string {
+	return `
+		variable {
+			name = "hostname"
+			path = "event.source"
+			type = "regex"
+			value = "Source host: (.*)"
+		}
+		extraction {
+			template = "{{variables.host}} is down"
+			target = "event.summary"
+		}`
+}
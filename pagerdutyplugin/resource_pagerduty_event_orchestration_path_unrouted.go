@@ -0,0 +1,282 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceEventOrchestrationPathUnrouted struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure    = (*resourceEventOrchestrationPathUnrouted)(nil)
+	_ resource.ResourceWithImportState  = (*resourceEventOrchestrationPathUnrouted)(nil)
+	_ resource.ResourceWithModifyPlan   = (*resourceEventOrchestrationPathUnrouted)(nil)
+	_ resource.ResourceWithUpgradeState = (*resourceEventOrchestrationPathUnrouted)(nil)
+)
+
+func (r *resourceEventOrchestrationPathUnrouted) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_event_orchestration_unrouted"
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleActions := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				// If there is only start set we don't need route_to
+				"route_to":     schema.StringAttribute{Optional: true},
+				"severity":     eventOrchestrationPathSeverityAttribute(),
+				"event_action": eventOrchestrationPathEventActionAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"variable":   eventOrchestrationPathVariableBlock(),
+				"extraction": eventOrchestrationPathExtractionBlock(),
+			},
+			Validators: []validator.Object{eventOrchestrationPathTemplateValidator{}},
+		},
+	}
+	catchAllActions := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				// By default suppress is set to "true" by API for unrouted
+				"suppress": schema.BoolAttribute{
+					Computed:      true,
+					PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				},
+				"severity":     eventOrchestrationPathSeverityAttribute(),
+				"event_action": eventOrchestrationPathEventActionAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"variable":   eventOrchestrationPathVariableBlock(),
+				"extraction": eventOrchestrationPathExtractionBlock(),
+			},
+			Validators: []validator.Object{eventOrchestrationPathTemplateValidator{}},
+		},
+	}
+
+	resp.Schema = eventOrchestrationPathSchema("event_orchestration", map[string]schema.Attribute{}, ruleActions, catchAllActions,
+		[]validator.List{eventOrchestrationPathSetsValidator{}},
+		nil,
+	)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceEventOrchestrationPathUnroutedModel
+	if d := req.Plan.Get(ctx, &plan); d.HasError() {
+		// Blocks not known yet, generated by dynamic blocks, are left to the apply.
+		return
+	}
+	var state resourceEventOrchestrationPathUnroutedModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	planEventOrchestrationPathRuleIDs(state.Sets, plan.Sets)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEventOrchestrationPathUnroutedModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, resourceEventOrchestrationPathUnroutedModel{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceEventOrchestrationPathUnroutedModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	unroutedPath, err := getEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "unrouted", id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading PagerDuty Event Orchestration Path of type unrouted for "+id, err.Error())
+		return
+	}
+	if unroutedPath == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = flattenEventOrchestrationPathUnrouted(id, unroutedPath, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceEventOrchestrationPathUnroutedModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model = r.put(ctx, model, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceEventOrchestrationPathUnroutedModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "unrouted", state.EventOrchestration.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+// ImportState imports the unrouted path of an orchestration given its ID.
+func (r *resourceEventOrchestrationPathUnrouted) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("event_orchestration"), req.ID)...)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return eventOrchestrationPathStateUpgraders(resp.Schema)
+}
+
+func (r *resourceEventOrchestrationPathUnrouted) put(ctx context.Context, model, state resourceEventOrchestrationPathUnroutedModel, diags *diag.Diagnostics) resourceEventOrchestrationPathUnroutedModel {
+	id := model.EventOrchestration.ValueString()
+	unroutedPath := &eventOrchestrationPath{
+		Parent: &eventOrchestrationPathReference{ID: id},
+		Sets:   expandEventOrchestrationPathSets(model.Sets, eventOrchestrationPathRuleIDs(state.Sets, model.Sets), expandEventOrchestrationPathUnroutedActions),
+		CatchAll: &eventOrchestrationPathCatchAll{
+			Actions: expandEventOrchestrationPathUnroutedCatchAllActions(model.CatchAll),
+		},
+	}
+
+	unroutedPath = putEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "unrouted", id, unroutedPath, diags)
+	if diags.HasError() {
+		return model
+	}
+	// The response holds the IDs of the rules just created.
+	return flattenEventOrchestrationPathUnrouted(id, unroutedPath, model)
+}
+
+type resourceEventOrchestrationPathUnroutedModel struct {
+	ID                 types.String                                                                              `tfsdk:"id"`
+	EventOrchestration types.String                                                                              `tfsdk:"event_orchestration"`
+	Sets               []eventOrchestrationPathSetModel[eventOrchestrationPathUnroutedActionsModel]              `tfsdk:"set"`
+	CatchAll           []eventOrchestrationPathCatchAllModel[eventOrchestrationPathUnroutedCatchAllActionsModel] `tfsdk:"catch_all"`
+}
+
+type eventOrchestrationPathUnroutedActionsModel struct {
+	RouteTo     types.String                            `tfsdk:"route_to"`
+	Severity    types.String                            `tfsdk:"severity"`
+	EventAction types.String                            `tfsdk:"event_action"`
+	Variables   []eventOrchestrationPathVariableModel   `tfsdk:"variable"`
+	Extractions []eventOrchestrationPathExtractionModel `tfsdk:"extraction"`
+}
+
+type eventOrchestrationPathUnroutedCatchAllActionsModel struct {
+	Suppress    types.Bool                              `tfsdk:"suppress"`
+	Severity    types.String                            `tfsdk:"severity"`
+	EventAction types.String                            `tfsdk:"event_action"`
+	Variables   []eventOrchestrationPathVariableModel   `tfsdk:"variable"`
+	Extractions []eventOrchestrationPathExtractionModel `tfsdk:"extraction"`
+}
+
+func expandEventOrchestrationPathUnroutedActions(a *eventOrchestrationPathUnroutedActionsModel) *eventOrchestrationPathActions {
+	actions := new(eventOrchestrationPathActions)
+	if a == nil {
+		return actions
+	}
+	actions.RouteTo = a.RouteTo.ValueString()
+	actions.Severity = a.Severity.ValueString()
+	actions.EventAction = a.EventAction.ValueString()
+	actions.Variables = expandEventOrchestrationPathVariables(a.Variables)
+	actions.Extractions = expandEventOrchestrationPathExtractions(a.Extractions)
+	return actions
+}
+
+func expandEventOrchestrationPathUnroutedCatchAllActions(catchAll []eventOrchestrationPathCatchAllModel[eventOrchestrationPathUnroutedCatchAllActionsModel]) *eventOrchestrationPathActions {
+	actions := new(eventOrchestrationPathActions)
+	a := eventOrchestrationPathCatchAllActions(catchAll)
+	if a == nil {
+		return actions
+	}
+	actions.Severity = a.Severity.ValueString()
+	actions.EventAction = a.EventAction.ValueString()
+	actions.Variables = expandEventOrchestrationPathVariables(a.Variables)
+	actions.Extractions = expandEventOrchestrationPathExtractions(a.Extractions)
+	return actions
+}
+
+func flattenEventOrchestrationPathUnrouted(id string, p *eventOrchestrationPath, prior resourceEventOrchestrationPathUnroutedModel) resourceEventOrchestrationPathUnroutedModel {
+	model := resourceEventOrchestrationPathUnroutedModel{
+		ID:                 types.StringValue(id),
+		EventOrchestration: types.StringValue(id),
+		Sets:               flattenEventOrchestrationPathSets(p.Sets, prior.Sets, flattenEventOrchestrationPathUnroutedActions),
+	}
+
+	a := new(eventOrchestrationPathActions)
+	if p.CatchAll != nil && p.CatchAll.Actions != nil {
+		a = p.CatchAll.Actions
+	}
+	priorActions := eventOrchestrationPathCatchAllActions(prior.CatchAll)
+	if priorActions == nil {
+		priorActions = new(eventOrchestrationPathUnroutedCatchAllActionsModel)
+	}
+	model.CatchAll = newEventOrchestrationPathCatchAll(&eventOrchestrationPathUnroutedCatchAllActionsModel{
+		Suppress:    types.BoolValue(a.Suppress),
+		Severity:    eventOrchestrationPathString(a.Severity, priorActions.Severity),
+		EventAction: eventOrchestrationPathString(a.EventAction, priorActions.EventAction),
+		Variables:   flattenEventOrchestrationPathVariables(a.Variables),
+		Extractions: flattenEventOrchestrationPathExtractions(a.Extractions, priorActions.Extractions),
+	})
+	return model
+}
+
+func flattenEventOrchestrationPathUnroutedActions(a *eventOrchestrationPathActions, prior *eventOrchestrationPathUnroutedActionsModel) *eventOrchestrationPathUnroutedActionsModel {
+	if a == nil {
+		a = new(eventOrchestrationPathActions)
+	}
+	if prior == nil {
+		prior = new(eventOrchestrationPathUnroutedActionsModel)
+	}
+
+	return &eventOrchestrationPathUnroutedActionsModel{
+		RouteTo:     eventOrchestrationPathString(a.RouteTo, prior.RouteTo),
+		Severity:    eventOrchestrationPathString(a.Severity, prior.Severity),
+		EventAction: eventOrchestrationPathString(a.EventAction, prior.EventAction),
+		Variables:   flattenEventOrchestrationPathVariables(a.Variables),
+		Extractions: flattenEventOrchestrationPathExtractions(a.Extractions, prior.Extractions),
+	}
+}
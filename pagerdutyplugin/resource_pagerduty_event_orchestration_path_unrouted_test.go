@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -25,9 +26,9 @@ func TestAccPagerDutyEventOrchestrationPathUnrouted_Basic(t *testing.T) {
 	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyEventOrchestrationPathUnroutedDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationPathUnroutedDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedConfigNoRules(team, escalationPolicy, service, orchestration),
@@ -184,21 +185,21 @@ func TestAccPagerDutyEventOrchestrationPathUnrouted_Basic(t *testing.T) {
 					team, escalationPolicy, service, orchestration, invalidExtractionRegexTemplateValConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: regex and template cannot both have values"),
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedInvalidExtractionsConfig(
 					team, escalationPolicy, service, orchestration, invalidExtractionRegexTemplateValConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: regex and template cannot both have values"),
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedInvalidExtractionsConfig(
 					team, escalationPolicy, service, orchestration, invalidExtractionRegexNilSourceConfig(), "",
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: source can't be blank"),
+				ExpectError: regexp.MustCompile("source can't be blank"),
 			},
 			// Providing invalid extractions attributes for the catch_all rule
 			{
@@ -206,21 +207,21 @@ func TestAccPagerDutyEventOrchestrationPathUnrouted_Basic(t *testing.T) {
 					team, escalationPolicy, service, orchestration, "", invalidExtractionRegexTemplateNilConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: regex and template cannot both be null"),
+				ExpectError: regexp.MustCompile("regex and template cannot both be null"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedInvalidExtractionsConfig(
 					team, escalationPolicy, service, orchestration, "", invalidExtractionRegexTemplateValConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: regex and template cannot both have values"),
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedInvalidExtractionsConfig(
 					team, escalationPolicy, service, orchestration, "", invalidExtractionRegexNilSourceConfig(),
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Invalid configuration in catch_all.0.actions.0.extraction.0: source can't be blank"),
+				ExpectError: regexp.MustCompile("source can't be blank"),
 			},
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationPathUnroutedConfigNoRules(team, escalationPolicy, service, orchestration),
@@ -240,17 +241,42 @@ func TestAccPagerDutyEventOrchestrationPathUnrouted_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEventOrchestrationPathUnrouted_SDKv2Compatibility(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_unrouted.unrouted"
+	config := testAccCheckPagerDutyEventOrchestrationPathUnroutedConfig(name, "info")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check:             resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.severity", "info"),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check:                    resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.severity", "info"),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyEventOrchestrationPathUnroutedDestroy(s *terraform.State) error {
-	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
-		if r.Type != "pagerduty_event_orchestration_path_unrouted" {
+		if r.Type != "pagerduty_event_orchestration_unrouted" {
 			continue
 		}
-
-		orch := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
-
-		if _, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "unrouted"); err == nil {
-			return fmt.Errorf("Event Orchestration Path still exists")
+		unrouted, err := testAccProvider.client.GetOrchestrationUnroutedWithContext(context.Background(), r.Primary.ID, nil)
+		if err != nil {
+			continue
+		}
+		for _, set := range unrouted.Sets {
+			if len(set.Rules) > 0 {
+				return fmt.Errorf("Event Orchestration Unrouted Path %s still has rules", r.Primary.ID)
+			}
 		}
 	}
 	return nil
@@ -267,8 +293,7 @@ func testAccCheckPagerDutyEventOrchestrationPathUnroutedExists(rn string) resour
 		}
 
 		orch := s.RootModule().Resources["pagerduty_event_orchestration.orch"]
-		client, _ := testAccProvider.Meta().(*Config).Client()
-		_, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "unrouted")
+		_, err := testAccProvider.client.GetOrchestrationUnroutedWithContext(context.Background(), orch.Primary.ID, nil)
 
 		if err != nil {
 			return fmt.Errorf("Event Orchestration Unrouted Path not found: %v for orchestration %v", "unrouted", orch.Primary.ID)
@@ -548,3 +573,30 @@ func testAccCheckPagerDutyEventOrchestrationPathUnroutedInvalidExtractionsConfig
 		`, re, cae),
 	)
 }
+
+func testAccCheckPagerDutyEventOrchestrationPathUnroutedConfig(name, severity string) string {
+	return testAccPagerDutyEventOrchestrationPathBaseConfig(name) + fmt.Sprintf(`
+resource "pagerduty_event_orchestration_unrouted" "unrouted" {
+	event_orchestration = pagerduty_event_orchestration.test.id
+
+	set {
+		id = "start"
+		rule {
+			label = "Classify unrouted events"
+			condition {
+				expression = "event.summary matches part 'unrouted'"
+			}
+			actions {
+				severity = "%s"
+			}
+		}
+	}
+
+	catch_all {
+		actions {
+			event_action = "trigger"
+		}
+	}
+}
+`, severity)
+}
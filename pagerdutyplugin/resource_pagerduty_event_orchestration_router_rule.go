@@ -0,0 +1,332 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceEventOrchestrationRouterRule struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure      = (*resourceEventOrchestrationRouterRule)(nil)
+	_ resource.ResourceWithImportState    = (*resourceEventOrchestrationRouterRule)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceEventOrchestrationRouterRule)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceEventOrchestrationRouterRule)(nil)
+)
+
+func (r *resourceEventOrchestrationRouterRule) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_event_orchestration_router_rule"
+}
+
+func (r *resourceEventOrchestrationRouterRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := eventOrchestrationPathRulePositionAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["event_orchestration"] = schema.StringAttribute{
+		Required:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	attributes["rule_id"] = schema.StringAttribute{
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["label"] = schema.StringAttribute{Optional: true}
+	attributes["disabled"] = schema.BoolAttribute{Optional: true}
+	attributes["rule_checksum"] = schema.StringAttribute{Computed: true}
+
+	actions := eventOrchestrationPathRouterActionsBlock()
+	actions.Validators = append(actions.Validators, eventOrchestrationPathRequiredBlockValidators...)
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"condition": eventOrchestrationPathConditionBlock(),
+			"actions":   actions,
+		},
+	}
+}
+
+// ValidateConfig checks the Dynamic Routing rule, which has to be the first
+// rule of the Router, without conditions or `route_to`.
+func (r *resourceEventOrchestrationRouterRule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config resourceEventOrchestrationRouterRuleModel
+	if d := req.Config.Get(ctx, &config); d.HasError() {
+		// Blocks not known yet, generated by dynamic blocks, are left to the apply.
+		return
+	}
+	actions := eventOrchestrationPathBlock(config.Actions)
+	if actions == nil {
+		return
+	}
+
+	errorMsgs := []string{}
+	hasDra := len(actions.DynamicRouteTo) > 0
+	if !hasDra && actions.RouteTo.IsNull() {
+		errorMsgs = append(errorMsgs, "at least one of 'route_to' or 'dynamic_route_to' must be specified in actions")
+	}
+	if hasDra {
+		if len(config.Conditions) > 0 {
+			errorMsgs = append(errorMsgs, "Dynamic Routing rules cannot have conditions")
+		}
+		if !actions.RouteTo.IsNull() {
+			errorMsgs = append(errorMsgs, "Dynamic Routing rules cannot have the `route_to` action")
+		}
+		if config.Priority.IsNull() || config.Priority.IsUnknown() || config.Priority.ValueInt64() != 0 {
+			errorMsgs = append(errorMsgs, "Dynamic Routing rules must be the first rule in a Router, set `priority` to 0")
+		}
+	}
+
+	if len(errorMsgs) > 0 {
+		resp.Diagnostics.AddError("Invalid Router rule configuration", "- "+strings.Join(errorMsgs, "\n- "))
+	}
+}
+
+func (r *resourceEventOrchestrationRouterRule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceEventOrchestrationRouterRuleModel
+	if d := req.Plan.Get(ctx, &plan); d.HasError() {
+		return
+	}
+	if actions := eventOrchestrationPathBlock(plan.Actions); actions != nil {
+		references := []eventOrchestrationReference{
+			{path.Root("actions").AtListIndex(0).AtName("route_to"), eventOrchestrationReferenceService, actions.RouteTo},
+		}
+		checkEventOrchestrationReferences(ctx, r.client, r.config.apiURL(), references, &resp.Diagnostics)
+	}
+}
+
+func (r *resourceEventOrchestrationRouterRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEventOrchestrationRouterRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oid := model.EventOrchestration.ValueString()
+	rule := eventOrchestrationPathRuleDocument(expandEventOrchestrationRouterRule(model))
+	var index int
+
+	log.Printf("[INFO] Creating PagerDuty Event Orchestration Router rule for orchestration: %s", oid)
+
+	doc, warnings, err := modifyEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "router", oid, eventOrchestrationPathRuleName("", model.Label), func(doc map[string]interface{}) error {
+		var err error
+		index, err = insertEventOrchestrationPathRule(eventOrchestrationRouterStartSet(doc), rule, model.position())
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating PagerDuty Event Orchestration Router rule for orchestration %s", oid), err.Error())
+		return
+	}
+	addEventOrchestrationPathWarnings(warnings, &resp.Diagnostics)
+
+	// The API assigns the ID of the rule, found at the index it was inserted at.
+	created := eventOrchestrationPathSetRule(findEventOrchestrationPathSet(doc, "start"), index)
+	if created == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating PagerDuty Event Orchestration Router rule for orchestration %s", oid), "The rule wasn't found after being created")
+		return
+	}
+
+	model = flattenEventOrchestrationRouterRule(oid, created, index, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationRouterRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceEventOrchestrationRouterRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oid, ruleID := state.EventOrchestration.ValueString(), state.RuleID.ValueString()
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Router rule %s for orchestration: %s", ruleID, oid)
+
+	doc, err := getEventOrchestrationPathDocument(ctx, r.client, r.config.apiURL(), "router", oid)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty Event Orchestration Router rule %s for orchestration %s", ruleID, oid), err.Error())
+		return
+	}
+	if doc == nil {
+		log.Printf("[WARN] Removing %s because the Event Orchestration %s doesn't exist anymore", state.ID.ValueString(), oid)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	set, index := findEventOrchestrationPathRule(doc, ruleID)
+	if set == nil {
+		log.Printf("[WARN] Removing %s because the rule was removed from the Router", state.ID.ValueString())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = flattenEventOrchestrationRouterRule(oid, eventOrchestrationPathSetRule(set, index), index, state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEventOrchestrationRouterRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceEventOrchestrationRouterRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oid, ruleID := state.EventOrchestration.ValueString(), state.RuleID.ValueString()
+	rule := expandEventOrchestrationRouterRule(model)
+	rule.ID = ruleID
+	ruleDoc := eventOrchestrationPathRuleDocument(rule)
+	var index int
+
+	log.Printf("[INFO] Updating PagerDuty Event Orchestration Router rule %s for orchestration: %s", ruleID, oid)
+
+	doc, warnings, err := modifyEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "router", oid, ruleID, func(doc map[string]interface{}) error {
+		set, i := findEventOrchestrationPathRule(doc, ruleID)
+		if set == nil {
+			return fmt.Errorf("Event Orchestration Router rule %s was removed from orchestration %s outside of Terraform", ruleID, oid)
+		}
+		if err := checkEventOrchestrationPathRuleConflict(eventOrchestrationPathSetRule(set, i), state.RuleChecksum); err != nil {
+			return err
+		}
+		if model.position().equal(state.position()) {
+			set["rules"].([]interface{})[i] = ruleDoc
+			index = i
+			return nil
+		}
+		removeEventOrchestrationPathRule(set, i)
+		var err error
+		index, err = insertEventOrchestrationPathRule(set, ruleDoc, model.position())
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating PagerDuty Event Orchestration Router rule %s for orchestration %s", ruleID, oid), err.Error())
+		return
+	}
+	addEventOrchestrationPathWarnings(warnings, &resp.Diagnostics)
+
+	if set, i := findEventOrchestrationPathRule(doc, ruleID); set != nil {
+		ruleDoc, index = eventOrchestrationPathSetRule(set, i), i
+	}
+	model = flattenEventOrchestrationRouterRule(oid, ruleDoc, index, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationRouterRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceEventOrchestrationRouterRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oid, ruleID := state.EventOrchestration.ValueString(), state.RuleID.ValueString()
+	log.Printf("[INFO] Deleting PagerDuty Event Orchestration Router rule %s for orchestration: %s", ruleID, oid)
+
+	_, _, err := modifyEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "router", oid, ruleID, func(doc map[string]interface{}) error {
+		set, i := findEventOrchestrationPathRule(doc, ruleID)
+		if set == nil {
+			return errEventOrchestrationPathRuleNotFound
+		}
+		removeEventOrchestrationPathRule(set, i)
+		return nil
+	})
+	if err != nil && !errors.Is(err, errEventOrchestrationPathRuleNotFound) && !errors.Is(err, errEventOrchestrationPathNotFound) && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting PagerDuty Event Orchestration Router rule %s for orchestration %s", ruleID, oid), err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEventOrchestrationRouterRule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+// ImportState imports a rule given the ID of its orchestration and its ID.
+func (r *resourceEventOrchestrationRouterRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	oid, ruleID, err := util.ResourcePagerDutyParseColonCompoundID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing pagerduty_event_orchestration_router_rule", "Expected import ID format: <orchestration_id>:<rule_id>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("event_orchestration"), oid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_id"), ruleID)...)
+}
+
+type resourceEventOrchestrationRouterRuleModel struct {
+	ID                 types.String                               `tfsdk:"id"`
+	EventOrchestration types.String                               `tfsdk:"event_orchestration"`
+	RuleID             types.String                               `tfsdk:"rule_id"`
+	Label              types.String                               `tfsdk:"label"`
+	Conditions         []eventOrchestrationPathConditionModel     `tfsdk:"condition"`
+	Actions            []eventOrchestrationPathRouterActionsModel `tfsdk:"actions"`
+	Disabled           types.Bool                                 `tfsdk:"disabled"`
+	Priority           types.Int64                                `tfsdk:"priority"`
+	InsertBefore       types.String                               `tfsdk:"insert_before"`
+	InsertAfter        types.String                               `tfsdk:"insert_after"`
+	Index              types.Int64                                `tfsdk:"index"`
+	RuleChecksum       types.String                               `tfsdk:"rule_checksum"`
+}
+
+func (m resourceEventOrchestrationRouterRuleModel) position() eventOrchestrationPathRulePosition {
+	return newEventOrchestrationPathRulePosition(m.Priority, m.InsertBefore, m.InsertAfter)
+}
+
+// eventOrchestrationRouterStartSet returns the only set of a Router, adding
+// it when the Router has no rules yet.
+func eventOrchestrationRouterStartSet(doc map[string]interface{}) map[string]interface{} {
+	if set := findEventOrchestrationPathSet(doc, "start"); set != nil {
+		return set
+	}
+	set := map[string]interface{}{"id": "start", "rules": []interface{}{}}
+	sets, _ := doc["sets"].([]interface{})
+	doc["sets"] = append(sets, set)
+	return set
+}
+
+func expandEventOrchestrationRouterRule(m resourceEventOrchestrationRouterRuleModel) *eventOrchestrationPathRule {
+	return &eventOrchestrationPathRule{
+		Label:      m.Label.ValueString(),
+		Disabled:   m.Disabled.ValueBool(),
+		Conditions: expandEventOrchestrationPathConditions(m.Conditions),
+		Actions:    expandEventOrchestrationPathRouterActions(eventOrchestrationPathBlock(m.Actions)),
+	}
+}
+
+func flattenEventOrchestrationRouterRule(oid string, doc map[string]interface{}, index int, prior resourceEventOrchestrationRouterRuleModel, diags *diag.Diagnostics) resourceEventOrchestrationRouterRuleModel {
+	rule, err := decodeEventOrchestrationPathRule(doc)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading PagerDuty Event Orchestration Router rule for orchestration %s", oid), err.Error())
+		return prior
+	}
+
+	prior.ID = types.StringValue(fmt.Sprintf("%s:%s", oid, rule.ID))
+	prior.EventOrchestration = types.StringValue(oid)
+	prior.RuleID = types.StringValue(rule.ID)
+	prior.Label = eventOrchestrationPathString(rule.Label, prior.Label)
+	prior.Disabled = eventOrchestrationPathBool(rule.Disabled, prior.Disabled)
+	prior.Conditions = flattenEventOrchestrationPathConditions(rule.Conditions)
+	prior.Actions = eventOrchestrationPathBlockItems(flattenEventOrchestrationPathRouterActions(rule.Actions, eventOrchestrationPathBlock(prior.Actions)))
+	prior.Index = types.Int64Value(int64(index))
+	prior.RuleChecksum = types.StringValue(eventOrchestrationPathRuleChecksum(doc))
+	return prior
+}
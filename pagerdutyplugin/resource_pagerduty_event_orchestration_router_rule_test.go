@@ -0,0 +1,236 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestInsertEventOrchestrationPathRule(t *testing.T) {
+	ids := func(set map[string]interface{}) string {
+		s := ""
+		for _, r := range set["rules"].([]interface{}) {
+			s += r.(map[string]interface{})["id"].(string)
+		}
+		return s
+	}
+	newSet := func() map[string]interface{} {
+		return map[string]interface{}{"id": "start", "rules": []interface{}{
+			map[string]interface{}{"id": "a"},
+			map[string]interface{}{"id": "b"},
+			map[string]interface{}{"id": "c"},
+		}}
+	}
+	priority := func(v int64) eventOrchestrationPathRulePosition {
+		return eventOrchestrationPathRulePosition{priority: types.Int64Value(v)}
+	}
+
+	cases := []struct {
+		position  eventOrchestrationPathRulePosition
+		wantRules string
+		wantIndex int
+	}{
+		{eventOrchestrationPathRulePosition{}, "abcx", 3},
+		{priority(0), "xabc", 0},
+		{priority(1), "axbc", 1},
+		{priority(10), "abcx", 3},
+		{eventOrchestrationPathRulePosition{anchor: "b"}, "axbc", 1},
+		{eventOrchestrationPathRulePosition{anchor: "c", after: true}, "abcx", 3},
+	}
+	for _, c := range cases {
+		set := newSet()
+		index, err := insertEventOrchestrationPathRule(set, map[string]interface{}{"id": "x"}, c.position)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ids(set); got != c.wantRules || index != c.wantIndex {
+			t.Errorf("%+v: want %s at %d; got %s at %d", c.position, c.wantRules, c.wantIndex, got, index)
+		}
+	}
+
+	if _, err := insertEventOrchestrationPathRule(newSet(), map[string]interface{}{"id": "x"}, eventOrchestrationPathRulePosition{anchor: "missing"}); err == nil {
+		t.Error("expected an error inserting next to a missing rule")
+	}
+}
+
+// TestModifyEventOrchestrationPathConflict checks that a path changed by
+// another client between being read and being written isn't written over.
+func TestModifyEventOrchestrationPathConflict(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"orchestration_path":{"version":"%d","sets":[{"id":"start","rules":[]}]}}`, len(methods))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:               "foo",
+		APIURLOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+	ctx := context.Background()
+	client, err := config.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = modifyEventOrchestrationPath(ctx, client, server.URL, "router", "E1", "R1", func(doc map[string]interface{}) error {
+		return nil
+	})
+	var conflict *eventOrchestrationPathConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected a conflict error, got: %v", err)
+	}
+	if !regexp.MustCompile(`router for E1 .* rule R1`).MatchString(err.Error()) {
+		t.Errorf("expected the error to name the path and the rule, got: %v", err)
+	}
+	for _, m := range methods {
+		if m != http.MethodGet {
+			t.Errorf("expected the path not to be written, got a %s request", m)
+		}
+	}
+}
+
+func TestAccPagerDutyEventOrchestrationRouterRule_Basic(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationRouterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationRouterRuleDynamicRoutingConfig(team, escalationPolicy, service, orchestration),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Dynamic Routing rules must be the first rule in a Router"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterRuleConfig(team, escalationPolicy, service, orchestration, "disk"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationRouterRuleExists("pagerduty_event_orchestration_router_rule.first"),
+					testAccCheckPagerDutyEventOrchestrationRouterRuleExists("pagerduty_event_orchestration_router_rule.second"),
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration_router_rule.first", "index", "0"),
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration_router_rule.second", "index", "1"),
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration_router_rule.first", "condition.0.expression", "event.summary matches part 'disk'"),
+					resource.TestCheckResourceAttrSet("pagerduty_event_orchestration_router_rule.first", "rule_checksum"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterRuleConfig(team, escalationPolicy, service, orchestration, "cpu"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration_router_rule.first", "condition.0.expression", "event.summary matches part 'cpu'"),
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration_router_rule.first", "index", "0"),
+				),
+			},
+			{
+				ResourceName:            "pagerduty_event_orchestration_router_rule.second",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"insert_after"},
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationRouterRuleDestroy(s *terraform.State) error {
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_event_orchestration_router_rule" {
+			continue
+		}
+
+		oid, ruleID, err := util.ResourcePagerDutyParseColonCompoundID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		doc, err := getEventOrchestrationPathDocument(context.Background(), testAccProvider.client, testAccAPIURL(), "router", oid)
+		if err != nil || doc == nil {
+			continue
+		}
+		if set, _ := findEventOrchestrationPathRule(doc, ruleID); set != nil {
+			return fmt.Errorf("Event Orchestration Router rule %s still exists", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEventOrchestrationRouterRuleExists(rn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Event Orchestration Router rule ID is set")
+		}
+
+		oid, ruleID, err := util.ResourcePagerDutyParseColonCompoundID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		doc, err := getEventOrchestrationPathDocument(context.Background(), testAccProvider.client, testAccAPIURL(), "router", oid)
+		if err != nil {
+			return err
+		}
+		if set, _ := findEventOrchestrationPathRule(doc, ruleID); set == nil {
+			return fmt.Errorf("Event Orchestration Router rule %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationRouterRuleConfig(t, ep, s, o, summary string) string {
+	return fmt.Sprintf("%s%s", createBaseConfig(t, ep, s, o), fmt.Sprintf(`
+	resource "pagerduty_event_orchestration_router_rule" "first" {
+		event_orchestration = pagerduty_event_orchestration.orch.id
+		label    = "first"
+		priority = 0
+		condition {
+			expression = "event.summary matches part '%s'"
+		}
+		actions {
+			route_to = pagerduty_service.bar.id
+		}
+	}
+
+	resource "pagerduty_event_orchestration_router_rule" "second" {
+		event_orchestration = pagerduty_event_orchestration.orch.id
+		label        = "second"
+		insert_after = pagerduty_event_orchestration_router_rule.first.rule_id
+		condition {
+			expression = "event.severity matches 'critical'"
+		}
+		actions {
+			route_to = pagerduty_service.bar.id
+		}
+	}
+	`, summary))
+}
+
+func testAccCheckPagerDutyEventOrchestrationRouterRuleDynamicRoutingConfig(t, ep, s, o string) string {
+	return fmt.Sprintf("%s%s", createBaseConfig(t, ep, s, o), `
+	resource "pagerduty_event_orchestration_router_rule" "dynamic" {
+		event_orchestration = pagerduty_event_orchestration.orch.id
+		actions {
+			dynamic_route_to {
+				lookup_by = "service_id"
+				regex     = "(.*)"
+				source    = "event.custom_details.pd_service_id"
+			}
+		}
+	}
+	`)
+}
@@ -0,0 +1,324 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type resourceEventOrchestrationServiceRule struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure   = (*resourceEventOrchestrationServiceRule)(nil)
+	_ resource.ResourceWithImportState = (*resourceEventOrchestrationServiceRule)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceEventOrchestrationServiceRule)(nil)
+)
+
+func (r *resourceEventOrchestrationServiceRule) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_event_orchestration_service_rule"
+}
+
+func (r *resourceEventOrchestrationServiceRule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := eventOrchestrationPathRulePositionAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["service"] = schema.StringAttribute{
+		Required:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	attributes["set"] = schema.StringAttribute{
+		Optional:      true,
+		Computed:      true,
+		Default:       stringdefault.StaticString("start"),
+		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	attributes["rule_id"] = schema.StringAttribute{
+		Computed:      true,
+		PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	}
+	attributes["label"] = schema.StringAttribute{Optional: true}
+	attributes["disabled"] = schema.BoolAttribute{Optional: true}
+	attributes["rule_checksum"] = schema.StringAttribute{Computed: true}
+
+	actions := eventOrchestrationPathServiceActionsBlock("")
+	actions.Validators = append(actions.Validators, eventOrchestrationPathRequiredBlockValidators...)
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"condition": eventOrchestrationPathConditionBlock(),
+			"actions":   actions,
+		},
+	}
+}
+
+func (r *resourceEventOrchestrationServiceRule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceEventOrchestrationServiceRuleModel
+	if d := req.Plan.Get(ctx, &plan); d.HasError() {
+		// Blocks not known yet, generated by dynamic blocks, are left to the apply.
+		return
+	}
+	actions := eventOrchestrationPathBlock(plan.Actions)
+	if actions == nil {
+		return
+	}
+
+	actionsPath := path.Root("actions").AtListIndex(0)
+	references := []eventOrchestrationReference{
+		{actionsPath.AtName("escalation_policy"), eventOrchestrationReferenceEscalationPolicy, actions.EscalationPolicy},
+	}
+	for i, action := range actions.PagerdutyAutomationAction {
+		references = append(references, eventOrchestrationReference{actionsPath.AtName("pagerduty_automation_action").AtListIndex(i).AtName("action_id"), eventOrchestrationReferenceAutomationAction, action.ActionID})
+	}
+	checkEventOrchestrationReferences(ctx, r.client, r.config.apiURL(), references, &resp.Diagnostics)
+}
+
+func (r *resourceEventOrchestrationServiceRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceEventOrchestrationServiceRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, setID := model.Service.ValueString(), model.Set.ValueString()
+	rule := eventOrchestrationPathRuleDocument(expandEventOrchestrationServiceRule(model))
+	var index int
+
+	log.Printf("[INFO] Creating PagerDuty Event Orchestration Service rule in set %s for service: %s", setID, serviceID)
+
+	doc, warnings, err := modifyEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "service", serviceID, eventOrchestrationPathRuleName("", model.Label), func(doc map[string]interface{}) error {
+		set, err := eventOrchestrationServiceRuleSet(doc, serviceID, setID)
+		if err != nil {
+			return err
+		}
+		index, err = insertEventOrchestrationPathRule(set, rule, model.position())
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating PagerDuty Event Orchestration Service rule in set %s for service %s", setID, serviceID), err.Error())
+		return
+	}
+	addEventOrchestrationPathWarnings(warnings, &resp.Diagnostics)
+
+	// The API assigns the ID of the rule, found at the index it was inserted at.
+	created := eventOrchestrationPathSetRule(findEventOrchestrationPathSet(doc, setID), index)
+	if created == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error creating PagerDuty Event Orchestration Service rule in set %s for service %s", setID, serviceID), "The rule wasn't found after being created")
+		return
+	}
+
+	model = flattenEventOrchestrationServiceRule(serviceID, setID, created, index, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationServiceRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceEventOrchestrationServiceRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, ruleID := state.Service.ValueString(), state.RuleID.ValueString()
+	log.Printf("[INFO] Reading PagerDuty Event Orchestration Service rule %s for service: %s", ruleID, serviceID)
+
+	doc, err := getEventOrchestrationPathDocument(ctx, r.client, r.config.apiURL(), "service", serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty Event Orchestration Service rule %s for service %s", ruleID, serviceID), err.Error())
+		return
+	}
+	if doc == nil {
+		log.Printf("[WARN] Removing %s because the service %s doesn't exist anymore", state.ID.ValueString(), serviceID)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	set, index := findEventOrchestrationPathRule(doc, ruleID)
+	if set == nil {
+		log.Printf("[WARN] Removing %s because the rule was removed from the Service Orchestration", state.ID.ValueString())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setID, _ := set["id"].(string)
+	state = flattenEventOrchestrationServiceRule(serviceID, setID, eventOrchestrationPathSetRule(set, index), index, state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEventOrchestrationServiceRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceEventOrchestrationServiceRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, setID, ruleID := state.Service.ValueString(), state.Set.ValueString(), state.RuleID.ValueString()
+	rule := expandEventOrchestrationServiceRule(model)
+	rule.ID = ruleID
+	ruleDoc := eventOrchestrationPathRuleDocument(rule)
+	var index int
+
+	log.Printf("[INFO] Updating PagerDuty Event Orchestration Service rule %s for service: %s", ruleID, serviceID)
+
+	doc, warnings, err := modifyEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "service", serviceID, ruleID, func(doc map[string]interface{}) error {
+		set, i := findEventOrchestrationPathRule(doc, ruleID)
+		if set == nil {
+			return fmt.Errorf("Event Orchestration Service rule %s was removed from service %s outside of Terraform", ruleID, serviceID)
+		}
+		if id, _ := set["id"].(string); id != setID {
+			return fmt.Errorf("Event Orchestration Service rule %s was moved to set %q outside of Terraform", ruleID, id)
+		}
+		if err := checkEventOrchestrationPathRuleConflict(eventOrchestrationPathSetRule(set, i), state.RuleChecksum); err != nil {
+			return err
+		}
+		if model.position().equal(state.position()) {
+			set["rules"].([]interface{})[i] = ruleDoc
+			index = i
+			return nil
+		}
+		removeEventOrchestrationPathRule(set, i)
+		var err error
+		index, err = insertEventOrchestrationPathRule(set, ruleDoc, model.position())
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating PagerDuty Event Orchestration Service rule %s for service %s", ruleID, serviceID), err.Error())
+		return
+	}
+	addEventOrchestrationPathWarnings(warnings, &resp.Diagnostics)
+
+	if set, i := findEventOrchestrationPathRule(doc, ruleID); set != nil {
+		ruleDoc, index = eventOrchestrationPathSetRule(set, i), i
+	}
+	model = flattenEventOrchestrationServiceRule(serviceID, setID, ruleDoc, index, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceEventOrchestrationServiceRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceEventOrchestrationServiceRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID, ruleID := state.Service.ValueString(), state.RuleID.ValueString()
+	log.Printf("[INFO] Deleting PagerDuty Event Orchestration Service rule %s for service: %s", ruleID, serviceID)
+
+	_, _, err := modifyEventOrchestrationPath(ctx, r.client, r.config.apiURL(), "service", serviceID, ruleID, func(doc map[string]interface{}) error {
+		set, i := findEventOrchestrationPathRule(doc, ruleID)
+		if set == nil {
+			return errEventOrchestrationPathRuleNotFound
+		}
+		removeEventOrchestrationPathRule(set, i)
+		return nil
+	})
+	if err != nil && !errors.Is(err, errEventOrchestrationPathRuleNotFound) && !errors.Is(err, errEventOrchestrationPathNotFound) && !util.IsNotFoundError(err) && !isForbiddenError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting PagerDuty Event Orchestration Service rule %s for service %s", ruleID, serviceID), err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceEventOrchestrationServiceRule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+// ImportState imports a rule given the ID of its service and its ID, the set
+// holding the rule is read along the rule.
+func (r *resourceEventOrchestrationServiceRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, ruleID, err := util.ResourcePagerDutyParseColonCompoundID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing pagerduty_event_orchestration_service_rule", "Expected import ID format: <service_id>:<rule_id>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_id"), ruleID)...)
+}
+
+type resourceEventOrchestrationServiceRuleModel struct {
+	ID           types.String                                `tfsdk:"id"`
+	Service      types.String                                `tfsdk:"service"`
+	Set          types.String                                `tfsdk:"set"`
+	RuleID       types.String                                `tfsdk:"rule_id"`
+	Label        types.String                                `tfsdk:"label"`
+	Conditions   []eventOrchestrationPathConditionModel      `tfsdk:"condition"`
+	Actions      []eventOrchestrationPathServiceActionsModel `tfsdk:"actions"`
+	Disabled     types.Bool                                  `tfsdk:"disabled"`
+	Priority     types.Int64                                 `tfsdk:"priority"`
+	InsertBefore types.String                                `tfsdk:"insert_before"`
+	InsertAfter  types.String                                `tfsdk:"insert_after"`
+	Index        types.Int64                                 `tfsdk:"index"`
+	RuleChecksum types.String                                `tfsdk:"rule_checksum"`
+}
+
+func (m resourceEventOrchestrationServiceRuleModel) position() eventOrchestrationPathRulePosition {
+	return newEventOrchestrationPathRulePosition(m.Priority, m.InsertBefore, m.InsertAfter)
+}
+
+// eventOrchestrationServiceRuleSet returns the set a rule is added to. The
+// `start` set always exists, other sets have to be created beforehand by
+// the owner of the Service Orchestration.
+func eventOrchestrationServiceRuleSet(doc map[string]interface{}, serviceID, setID string) (map[string]interface{}, error) {
+	if set := findEventOrchestrationPathSet(doc, setID); set != nil {
+		return set, nil
+	}
+	if setID != "start" {
+		return nil, fmt.Errorf("Set %q doesn't exist in the Service Orchestration of service %s", setID, serviceID)
+	}
+	set := map[string]interface{}{"id": setID, "rules": []interface{}{}}
+	sets, _ := doc["sets"].([]interface{})
+	doc["sets"] = append([]interface{}{set}, sets...)
+	return set, nil
+}
+
+func expandEventOrchestrationServiceRule(m resourceEventOrchestrationServiceRuleModel) *eventOrchestrationPathRule {
+	return &eventOrchestrationPathRule{
+		Label:      m.Label.ValueString(),
+		Disabled:   m.Disabled.ValueBool(),
+		Conditions: expandEventOrchestrationPathConditions(m.Conditions),
+		Actions:    expandEventOrchestrationPathServiceActions(eventOrchestrationPathBlock(m.Actions)),
+	}
+}
+
+func flattenEventOrchestrationServiceRule(serviceID, setID string, doc map[string]interface{}, index int, prior resourceEventOrchestrationServiceRuleModel, diags *diag.Diagnostics) resourceEventOrchestrationServiceRuleModel {
+	rule, err := decodeEventOrchestrationPathRule(doc)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading PagerDuty Event Orchestration Service rule for service %s", serviceID), err.Error())
+		return prior
+	}
+
+	prior.ID = types.StringValue(fmt.Sprintf("%s:%s", serviceID, rule.ID))
+	prior.Service = types.StringValue(serviceID)
+	prior.Set = types.StringValue(setID)
+	prior.RuleID = types.StringValue(rule.ID)
+	prior.Label = eventOrchestrationPathString(rule.Label, prior.Label)
+	prior.Disabled = eventOrchestrationPathBool(rule.Disabled, prior.Disabled)
+	prior.Conditions = flattenEventOrchestrationPathConditions(rule.Conditions)
+	prior.Actions = eventOrchestrationPathBlockItems(flattenEventOrchestrationPathServiceActions(rule.Actions, eventOrchestrationPathBlock(prior.Actions)))
+	prior.Index = types.Int64Value(int64(index))
+	prior.RuleChecksum = types.StringValue(eventOrchestrationPathRuleChecksum(doc))
+	return prior
+}
@@ -0,0 +1,173 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCheckEventOrchestrationPathRuleConflict(t *testing.T) {
+	rule := eventOrchestrationPathRuleDocument(&eventOrchestrationPathRule{
+		ID:    "abc",
+		Label: "maintenance",
+		Conditions: []*eventOrchestrationPathCondition{
+			{Expression: "event.summary matches part 'maintenance'"},
+		},
+		Actions: &eventOrchestrationPathActions{Suppress: true},
+	})
+	checksum := types.StringValue(eventOrchestrationPathRuleChecksum(rule))
+
+	if err := checkEventOrchestrationPathRuleConflict(rule, checksum); err != nil {
+		t.Errorf("unexpected conflict: %s", err)
+	}
+	if err := checkEventOrchestrationPathRuleConflict(rule, types.StringNull()); err != nil {
+		t.Errorf("unexpected conflict without checksum: %s", err)
+	}
+
+	rule["actions"].(map[string]interface{})["suppress"] = false
+	if err := checkEventOrchestrationPathRuleConflict(rule, checksum); err == nil {
+		t.Error("expected a conflict for a rule changed since it was read")
+	}
+}
+
+func TestAccPagerDutyEventOrchestrationServiceRule_Basic(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_service_rule.maintenance"
+	var serviceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyEventOrchestrationServiceRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationServiceRuleConfig(escalationPolicy, service, "maintenance"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationServiceRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "set", "start"),
+					resource.TestCheckResourceAttr(resourceName, "index", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_checksum"),
+					func(s *terraform.State) error {
+						serviceID = s.RootModule().Resources[resourceName].Primary.Attributes["service"]
+						return nil
+					},
+				),
+			},
+			// A rule added outside of Terraform is kept when the managed rule changes.
+			{
+				PreConfig: testAccAddPagerDutyEventOrchestrationServiceRule(t, &serviceID),
+				Config:    testAccCheckPagerDutyEventOrchestrationServiceRuleConfig(escalationPolicy, service, "planned maintenance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "condition.0.expression", "event.summary matches part 'planned maintenance'"),
+					testAccCheckPagerDutyEventOrchestrationServiceRuleCount(resourceName, 2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"priority"},
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEventOrchestrationServiceRuleDestroy(s *terraform.State) error {
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_event_orchestration_service_rule" {
+			continue
+		}
+
+		serviceID, ruleID, err := util.ResourcePagerDutyParseColonCompoundID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		doc, err := getEventOrchestrationPathDocument(context.Background(), testAccProvider.client, testAccAPIURL(), "service", serviceID)
+		if err != nil || doc == nil {
+			continue
+		}
+		if set, _ := findEventOrchestrationPathRule(doc, ruleID); set != nil {
+			return fmt.Errorf("Event Orchestration Service rule %s still exists", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyEventOrchestrationServiceRuleExists(rn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Event Orchestration Service rule ID is set")
+		}
+
+		serviceID, ruleID, err := util.ResourcePagerDutyParseColonCompoundID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		doc, err := getEventOrchestrationPathDocument(context.Background(), testAccProvider.client, testAccAPIURL(), "service", serviceID)
+		if err != nil {
+			return err
+		}
+		if set, _ := findEventOrchestrationPathRule(doc, ruleID); set == nil {
+			return fmt.Errorf("Event Orchestration Service rule %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationServiceRuleCount(rn string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[rn]
+		doc, err := getEventOrchestrationPathDocument(context.Background(), testAccProvider.client, testAccAPIURL(), "service", rs.Primary.Attributes["service"])
+		if err != nil {
+			return err
+		}
+		set := findEventOrchestrationPathSet(doc, "start")
+		if rules, _ := set["rules"].([]interface{}); len(rules) != count {
+			return fmt.Errorf("Expected %d rules in the start set of the Service Orchestration", count)
+		}
+		return nil
+	}
+}
+
+func testAccAddPagerDutyEventOrchestrationServiceRule(t *testing.T, serviceID *string) func() {
+	return func() {
+		_, _, err := modifyEventOrchestrationPath(context.Background(), testAccProvider.client, testAccAPIURL(), "service", *serviceID, "owned by the service team", func(doc map[string]interface{}) error {
+			_, err := insertEventOrchestrationPathRule(findEventOrchestrationPathSet(doc, "start"), eventOrchestrationPathRuleDocument(&eventOrchestrationPathRule{
+				Label:      "owned by the service team",
+				Conditions: []*eventOrchestrationPathCondition{},
+				Actions:    &eventOrchestrationPathActions{Annotate: "Added by the service team"},
+			}), eventOrchestrationPathRulePosition{})
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationServiceRuleConfig(ep, s, summary string) string {
+	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s), fmt.Sprintf(`
+	resource "pagerduty_event_orchestration_service_rule" "maintenance" {
+		service  = pagerduty_service.bar.id
+		label    = "Suppress maintenance events"
+		priority = 0
+		condition {
+			expression = "event.summary matches part '%s'"
+		}
+		actions {
+			suppress = true
+		}
+	}
+	`, summary))
+}
@@ -0,0 +1,954 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type resourceSchedule struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure    = (*resourceSchedule)(nil)
+	_ resource.ResourceWithImportState  = (*resourceSchedule)(nil)
+	_ resource.ResourceWithModifyPlan   = (*resourceSchedule)(nil)
+	_ resource.ResourceWithUpgradeState = (*resourceSchedule)(nil)
+)
+
+func (r *resourceSchedule) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_schedule"
+}
+
+// scheduleTimeOfDayRegexp matches the time of the day a restriction starts.
+var scheduleTimeOfDayRegexp = regexp.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`)
+
+func (r *resourceSchedule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:            1,
+		DeprecationMessage: "Use pagerduty_schedulev2 instead. pagerduty_schedule uses the legacy v1 API and will be removed in a future release.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"time_zone": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{validate.ValidTimeZone()},
+			},
+			"overflow": schema.BoolAttribute{Optional: true},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Managed by Terraform"),
+			},
+			"teams": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"final_schedule": schema.ListAttribute{
+				Computed:    true,
+				ElementType: scheduleFinalScheduleObjectType,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"layer": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"name": schema.StringAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						},
+						"start": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{scheduleTimeValidator{}},
+						},
+						"end": schema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{scheduleTimeValidator{fullMinute: true}},
+						},
+						"rotation_virtual_start": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{scheduleTimeValidator{fullMinute: true}},
+						},
+						"rotation_turn_length_seconds": schema.Int64Attribute{
+							Required:   true,
+							Validators: []validator.Int64{int64validator.Between(3600, 365*24*3600)},
+						},
+						"users": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+						},
+						"rendered_coverage_percentage": schema.StringAttribute{Computed: true},
+					},
+					Blocks: map[string]schema.Block{
+						"restriction": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Validators: []validator.Object{scheduleRestrictionValidator{}},
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{stringvalidator.OneOf("daily_restriction", "weekly_restriction")},
+									},
+									"start_time_of_day": schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{stringvalidator.RegexMatches(scheduleTimeOfDayRegexp, "must be of 00:00:00 format")},
+									},
+									"start_day_of_week": schema.Int64Attribute{
+										Optional:   true,
+										Validators: []validator.Int64{int64validator.Between(0, 7)},
+									},
+									"duration_seconds": schema.Int64Attribute{
+										Required:   true,
+										Validators: []validator.Int64{int64validator.Between(1, 7*24*3600-1)},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSchedule) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if deletionProtection.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), planDeletionProtection(r.config))...)
+	}
+
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var teams types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("teams"), &teams)...)
+	var abilities []string
+	if len(teams.Elements()) > 0 {
+		abilities = append(abilities, "teams")
+	}
+	checkPreflight(r.config, "pagerduty_schedule", "schedules.write", abilities, &resp.Diagnostics)
+}
+
+func (r *resourceSchedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := buildSchedulePayload(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Creating PagerDuty schedule %s", plan.Name)
+
+	var created schedulePayloadEnvelope
+	err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPost, "/schedules"+scheduleOverflowQuery(model.Overflow), schedulePayloadEnvelope{Schedule: plan}, &created)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error creating PagerDuty schedule %s", plan.Name), err)
+		return
+	}
+
+	schedule, err := r.requestGetSchedule(ctx, created.Schedule.ID, true)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty schedule %s", created.Schedule.ID), err.Error())
+		return
+	}
+	model = flattenSchedule(ctx, schedule, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Reading PagerDuty schedule %s", state.ID)
+
+	schedule, err := r.requestGetSchedule(ctx, state.ID.ValueString(), false)
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			log.Printf("[WARN] Removing %s because it's gone", state.ID)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty schedule %s", state.ID), err.Error())
+		return
+	}
+
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	state = flattenSchedule(ctx, schedule, state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSchedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing deletion_protection alone doesn't need any request.
+	onlyDeletionProtection := req.Plan
+	resp.Diagnostics.Append(onlyDeletionProtection.SetAttribute(ctx, path.Root("deletion_protection"), state.DeletionProtection)...)
+	if onlyDeletionProtection.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		return
+	}
+
+	plan := buildSchedulePayload(ctx, &model, &resp.Diagnostics)
+	prior := buildSchedulePayload(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	// A schedule layer can never be removed but it can be ended, so layers
+	// removed from the configuration are ended now to avoid diffs.
+	for _, o := range prior.ScheduleLayers {
+		found := slices.ContainsFunc(plan.ScheduleLayers, func(n *scheduleLayerPayload) bool { return n.ID == o.ID })
+		if !found {
+			end := time.Now().UTC().Format(time.RFC3339)
+			o.End = &end
+			plan.ScheduleLayers = append(plan.ScheduleLayers, o)
+		}
+	}
+	log.Printf("[INFO] Updating PagerDuty schedule %s", id)
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPut, "/schedules/"+id+scheduleOverflowQuery(model.Overflow), schedulePayloadEnvelope{Schedule: plan}, nil)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error updating PagerDuty schedule %s", id), err)
+		return
+	}
+
+	schedule, err := r.requestGetSchedule(ctx, id, false)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty schedule %s", id), err.Error())
+		return
+	}
+	model = flattenSchedule(ctx, schedule, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceSchedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	var deletionProtection types.Bool
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkDeletionProtection("pagerduty_schedule", id.ValueString(), deletionProtection, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	scheduleID := id.ValueString()
+	log.Printf("[INFO] Deleting PagerDuty schedule %s", scheduleID)
+
+	schedule, err := r.requestGetSchedule(ctx, scheduleID, false)
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty schedule %s", scheduleID), err.Error())
+		return
+	}
+
+	// Retrying to give other resources (such as escalation policies) time to
+	// delete, and to dissociate the escalation policies still using the
+	// schedule.
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := r.client.DeleteScheduleWithContext(ctx, scheduleID)
+		if err == nil || util.IsNotFoundError(err) {
+			return nil
+		}
+		if !util.IsBadRequestError(err) {
+			return retry.RetryableError(err)
+		}
+
+		usedByEPs := scheduleDeleteErrorIs(err, "Schedule can't be deleted if it's being used by escalation policies")
+		withOpenIncidents := scheduleDeleteErrorIs(err, "Schedule can't be deleted if it's being used by an escalation policy snapshot with open incidents")
+		if !usedByEPs && !withOpenIncidents {
+			return retry.NonRetryableError(err)
+		}
+
+		// A schedule with open incidents can't be deleted until they are
+		// resolved.
+		incidents, listErr := r.listOpenIncidentsOfSchedule(ctx, schedule)
+		if listErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v; %w", err, listErr))
+		}
+		if len(incidents) > 0 {
+			return retry.NonRetryableError(fmt.Errorf("Before destroying Schedule %q You must first resolve or reassign the following incidents related with Escalation Policies using this Schedule...\n%s", scheduleID, strings.Join(incidents, "\n")))
+		}
+		if withOpenIncidents {
+			return retry.NonRetryableError(err)
+		}
+
+		eps, fetchErr := r.fetchEscalationPoliciesOfSchedule(ctx, schedule)
+		if fetchErr != nil {
+			return retry.RetryableError(fmt.Errorf("%v; %w", err, fetchErr))
+		}
+		if blockErr := scheduleUsedByEscalationPoliciesWithOneLayer(scheduleID, eps); blockErr != nil {
+			return retry.NonRetryableError(blockErr)
+		}
+
+		log.Printf("[INFO] Dissociating Escalation Policies that use the Schedule %s", scheduleID)
+		for _, ep := range eps {
+			if dissociateErr := r.removeScheduleFromEscalationPolicy(ctx, scheduleID, ep); dissociateErr != nil {
+				err = fmt.Errorf("%v; %w; Error while trying to dissociate Schedule %q from Escalation Policy %q", err, dissociateErr, scheduleID, ep.ID)
+				break
+			}
+		}
+		return retry.RetryableError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting PagerDuty schedule %s", scheduleID), err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceSchedule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceSchedule) requestGetSchedule(ctx context.Context, id string, retryNotFound bool) (*schedulePayload, error) {
+	var schedule schedulePayloadEnvelope
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodGet, "/schedules/"+id, nil, &schedule)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			if !retryNotFound && util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schedule.Schedule, nil
+}
+
+// listOpenIncidentsOfSchedule returns the links to the incidents open on the
+// escalation policies using a schedule, assigned to the users of the
+// schedule.
+func (r *resourceSchedule) listOpenIncidentsOfSchedule(ctx context.Context, schedule *schedulePayload) ([]string, error) {
+	opts := pagerduty.ListIncidentsOptions{
+		DateRange: "all",
+		Statuses:  []string{"triggered", "acknowledged"},
+		Limit:     100,
+	}
+	for _, u := range schedule.Users {
+		opts.UserIDs = append(opts.UserIDs, u.ID)
+	}
+	var eps []string
+	for _, ep := range schedule.EscalationPolicies {
+		eps = append(eps, ep.ID)
+	}
+
+	var links []string
+	for {
+		var list *pagerduty.ListIncidentsResponse
+		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			var err error
+			list, err = r.client.ListIncidentsWithContext(ctx, opts)
+			if err != nil {
+				if util.IsBadRequestError(err) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, incident := range list.Incidents {
+			if slices.Contains(eps, incident.EscalationPolicy.ID) {
+				links = append(links, incident.HTMLURL)
+			}
+		}
+		if !list.More {
+			return links, nil
+		}
+		opts.Offset += opts.Limit
+	}
+}
+
+func (r *resourceSchedule) fetchEscalationPoliciesOfSchedule(ctx context.Context, schedule *schedulePayload) ([]*escalationPolicyPayload, error) {
+	eps := []*escalationPolicyPayload{}
+	for _, ref := range schedule.EscalationPolicies {
+		var ep escalationPolicyPayloadEnvelope
+		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodGet, "/escalation_policies/"+ref.ID, nil, &ep)
+			if err != nil {
+				if util.IsBadRequestError(err) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return eps, err
+		}
+		eps = append(eps, ep.EscalationPolicy)
+	}
+	return eps, nil
+}
+
+// removeScheduleFromEscalationPolicy removes a schedule from the targets of
+// an escalation policy with more than one rule, along with the rules left
+// without any target.
+func (r *resourceSchedule) removeScheduleFromEscalationPolicy(ctx context.Context, scheduleID string, ep *escalationPolicyPayload) error {
+	if len(ep.EscalationRules) < 2 {
+		return nil
+	}
+
+	updated := false
+	rules := []*escalationPolicyRulePayload{}
+	for _, rule := range ep.EscalationRules {
+		targets := []*escalationPolicyReference{}
+		for _, target := range rule.Targets {
+			if normalizeEscalationTargetType(target.Type) == "schedule_reference" && target.ID == scheduleID {
+				updated = true
+				continue
+			}
+			targets = append(targets, target)
+		}
+		if len(targets) == 0 {
+			continue
+		}
+		rule.Targets = targets
+		rules = append(rules, rule)
+	}
+	if !updated {
+		return nil
+	}
+	ep.EscalationRules = rules
+
+	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPut, "/escalation_policies/"+ep.ID, escalationPolicyPayloadEnvelope{EscalationPolicy: ep}, nil)
+		if err != nil && !util.IsNotFoundError(err) {
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+// scheduleUsedByEscalationPoliciesWithOneLayer returns an error listing the
+// escalation policies the schedule can't be dissociated from, because they
+// would be left without any target.
+func scheduleUsedByEscalationPoliciesWithOneLayer(scheduleID string, eps []*escalationPolicyPayload) error {
+	var blocking []string
+	for _, ep := range eps {
+		if len(ep.EscalationRules) == 0 {
+			continue
+		}
+		onlyThisSchedule := true
+		for _, rule := range ep.EscalationRules {
+			if len(rule.Targets) != 1 || normalizeEscalationTargetType(rule.Targets[0].Type) != "schedule_reference" || rule.Targets[0].ID != scheduleID {
+				onlyThisSchedule = false
+				break
+			}
+		}
+		oneLayer := len(ep.EscalationRules) == 1 && len(ep.EscalationRules[0].Targets) == 1
+		if oneLayer || onlyThisSchedule {
+			blocking = append(blocking, fmt.Sprintf("%s (%s)", ep.Name, ep.HTMLURL))
+		}
+	}
+	if len(blocking) == 0 {
+		return nil
+	}
+	return fmt.Errorf("It is not possible to continue with the destruction of the Schedule %q, because it is being used by Escalation Policies which would be left without any target. Change or destroy the following Escalation Policies first, e.g. with \"terraform apply -target=pagerduty_escalation_policy.example\", and retry...\n%s", scheduleID, strings.Join(blocking, "\n"))
+}
+
+func scheduleDeleteErrorIs(err error, message string) bool {
+	var apiErr pagerduty.APIError
+	if !errors.As(err, &apiErr) || !apiErr.APIError.Valid {
+		return false
+	}
+	messages := apiErr.APIError.ErrorObject.Errors
+	return len(messages) == 1 && messages[0] == message
+}
+
+func scheduleOverflowQuery(overflow types.Bool) string {
+	if overflow.ValueBool() {
+		return "?overflow=true"
+	}
+	return ""
+}
+
+type resourceScheduleModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	TimeZone           types.String `tfsdk:"time_zone"`
+	Overflow           types.Bool   `tfsdk:"overflow"`
+	Description        types.String `tfsdk:"description"`
+	Layer              types.List   `tfsdk:"layer"`
+	Teams              types.List   `tfsdk:"teams"`
+	FinalSchedule      types.List   `tfsdk:"final_schedule"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+type scheduleLayerModel struct {
+	ID                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	Start                      types.String `tfsdk:"start"`
+	End                        types.String `tfsdk:"end"`
+	RotationVirtualStart       types.String `tfsdk:"rotation_virtual_start"`
+	RotationTurnLengthSeconds  types.Int64  `tfsdk:"rotation_turn_length_seconds"`
+	Users                      types.List   `tfsdk:"users"`
+	RenderedCoveragePercentage types.String `tfsdk:"rendered_coverage_percentage"`
+	Restriction                types.List   `tfsdk:"restriction"`
+}
+
+type scheduleRestrictionModel struct {
+	Type            types.String `tfsdk:"type"`
+	StartTimeOfDay  types.String `tfsdk:"start_time_of_day"`
+	StartDayOfWeek  types.Int64  `tfsdk:"start_day_of_week"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+}
+
+var (
+	scheduleFinalScheduleObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":                         types.StringType,
+		"rendered_coverage_percentage": types.StringType,
+	}}
+	scheduleRestrictionObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":              types.StringType,
+		"start_time_of_day": types.StringType,
+		"start_day_of_week": types.Int64Type,
+		"duration_seconds":  types.Int64Type,
+	}}
+	scheduleLayerObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":                           types.StringType,
+		"name":                         types.StringType,
+		"start":                        types.StringType,
+		"end":                          types.StringType,
+		"rotation_virtual_start":       types.StringType,
+		"rotation_turn_length_seconds": types.Int64Type,
+		"users":                        types.ListType{ElemType: types.StringType},
+		"rendered_coverage_percentage": types.StringType,
+		"restriction":                  types.ListType{ElemType: scheduleRestrictionObjectType},
+	}}
+)
+
+// schedulePayload is a schedule as sent to and returned by the API. Unlike
+// the client library's, it can unset the end of a layer.
+type schedulePayload struct {
+	ID                 string                  `json:"id,omitempty"`
+	Type               string                  `json:"type,omitempty"`
+	Name               string                  `json:"name,omitempty"`
+	TimeZone           string                  `json:"time_zone"`
+	Description        string                  `json:"description,omitempty"`
+	Teams              []*scheduleReference    `json:"teams"`
+	ScheduleLayers     []*scheduleLayerPayload `json:"schedule_layers"`
+	EscalationPolicies []*scheduleReference    `json:"escalation_policies,omitempty"`
+	Users              []*scheduleReference    `json:"users,omitempty"`
+	FinalSchedule      *scheduleSubSchedule    `json:"final_schedule,omitempty"`
+}
+
+type schedulePayloadEnvelope struct {
+	Schedule *schedulePayload `json:"schedule"`
+}
+
+type scheduleReference struct {
+	ID   string `json:"id"`
+	Type string `json:"type,omitempty"`
+}
+
+type scheduleLayerPayload struct {
+	ID                         string                        `json:"id,omitempty"`
+	Name                       string                        `json:"name,omitempty"`
+	Start                      string                        `json:"start"`
+	End                        *string                       `json:"end"`
+	RotationVirtualStart       string                        `json:"rotation_virtual_start"`
+	RotationTurnLengthSeconds  int                           `json:"rotation_turn_length_seconds"`
+	Users                      []*scheduleLayerUser          `json:"users"`
+	Restrictions               []*scheduleRestrictionPayload `json:"restrictions"`
+	RenderedCoveragePercentage float64                       `json:"rendered_coverage_percentage,omitempty"`
+}
+
+type scheduleLayerUser struct {
+	User *scheduleReference `json:"user"`
+}
+
+type scheduleRestrictionPayload struct {
+	Type            string `json:"type"`
+	StartTimeOfDay  string `json:"start_time_of_day"`
+	StartDayOfWeek  int    `json:"start_day_of_week,omitempty"`
+	DurationSeconds int    `json:"duration_seconds"`
+}
+
+type scheduleSubSchedule struct {
+	Name                       string  `json:"name"`
+	RenderedCoveragePercentage float64 `json:"rendered_coverage_percentage"`
+}
+
+func buildSchedulePayload(ctx context.Context, model *resourceScheduleModel, diags *diag.Diagnostics) *schedulePayload {
+	schedule := &schedulePayload{
+		Type:           "schedule",
+		Name:           model.Name.ValueString(),
+		TimeZone:       model.TimeZone.ValueString(),
+		Description:    model.Description.ValueString(),
+		Teams:          []*scheduleReference{},
+		ScheduleLayers: []*scheduleLayerPayload{},
+	}
+
+	if !model.Teams.IsNull() && !model.Teams.IsUnknown() {
+		var teams []string
+		diags.Append(model.Teams.ElementsAs(ctx, &teams, false)...)
+		for _, team := range teams {
+			schedule.Teams = append(schedule.Teams, &scheduleReference{ID: team, Type: "team_reference"})
+		}
+	}
+
+	var layers []scheduleLayerModel
+	diags.Append(model.Layer.ElementsAs(ctx, &layers, false)...)
+	for i, l := range layers {
+		// The API returns a rotation_virtual_start given with an offset
+		// shifted by that offset, so it is always sent in UTC.
+		rvs, err := util.TimeToUTC(l.RotationVirtualStart.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("layer").AtListIndex(i).AtName("rotation_virtual_start"), "Invalid schedule layer", err.Error())
+			continue
+		}
+
+		layer := &scheduleLayerPayload{
+			Name:                      l.Name.ValueString(),
+			Start:                     l.Start.ValueString(),
+			RotationVirtualStart:      rvs.Format(time.RFC3339),
+			RotationTurnLengthSeconds: int(l.RotationTurnLengthSeconds.ValueInt64()),
+			Users:                     []*scheduleLayerUser{},
+			Restrictions:              []*scheduleRestrictionPayload{},
+		}
+		if !l.ID.IsUnknown() {
+			layer.ID = l.ID.ValueString()
+		}
+		if isKnownValue(l.End) && l.End.ValueString() != "" {
+			end := l.End.ValueString()
+			layer.End = &end
+		}
+
+		var users []string
+		diags.Append(l.Users.ElementsAs(ctx, &users, false)...)
+		for _, user := range users {
+			layer.Users = append(layer.Users, &scheduleLayerUser{User: &scheduleReference{ID: user, Type: "user_reference"}})
+		}
+
+		var restrictions []scheduleRestrictionModel
+		if !l.Restriction.IsNull() && !l.Restriction.IsUnknown() {
+			diags.Append(l.Restriction.ElementsAs(ctx, &restrictions, false)...)
+		}
+		for _, rs := range restrictions {
+			layer.Restrictions = append(layer.Restrictions, &scheduleRestrictionPayload{
+				Type:            rs.Type.ValueString(),
+				StartTimeOfDay:  rs.StartTimeOfDay.ValueString(),
+				StartDayOfWeek:  int(rs.StartDayOfWeek.ValueInt64()),
+				DurationSeconds: int(rs.DurationSeconds.ValueInt64()),
+			})
+		}
+
+		schedule.ScheduleLayers = append(schedule.ScheduleLayers, layer)
+	}
+
+	return schedule
+}
+
+// flattenSchedule sets the values of a schedule read from the API onto the
+// prior model, which is the state or the plan just applied. Times equal to
+// their prior value keep the prior representation.
+func flattenSchedule(ctx context.Context, schedule *schedulePayload, prior resourceScheduleModel, diags *diag.Diagnostics) resourceScheduleModel {
+	model := prior
+	model.ID = types.StringValue(schedule.ID)
+	model.Name = types.StringValue(schedule.Name)
+	model.TimeZone = types.StringValue(schedule.TimeZone)
+	model.Description = types.StringValue(schedule.Description)
+
+	model.Teams = types.ListNull(types.StringType)
+	if len(schedule.Teams) > 0 {
+		teams := make([]attr.Value, 0, len(schedule.Teams))
+		for _, team := range schedule.Teams {
+			teams = append(teams, types.StringValue(team.ID))
+		}
+		model.Teams = types.ListValueMust(types.StringType, teams)
+	}
+
+	finalSchedule := []attr.Value{}
+	if schedule.FinalSchedule != nil {
+		finalSchedule = append(finalSchedule, types.ObjectValueMust(scheduleFinalScheduleObjectType.AttrTypes, map[string]attr.Value{
+			"name":                         types.StringValue(schedule.FinalSchedule.Name),
+			"rendered_coverage_percentage": types.StringValue(util.RenderRoundedPercentage(schedule.FinalSchedule.RenderedCoveragePercentage)),
+		}))
+	}
+	model.FinalSchedule = types.ListValueMust(scheduleFinalScheduleObjectType, finalSchedule)
+
+	var priorLayers []scheduleLayerModel
+	if !prior.Layer.IsNull() && !prior.Layer.IsUnknown() {
+		diags.Append(prior.Layer.ElementsAs(ctx, &priorLayers, false)...)
+	}
+
+	// Layers are returned from the last to the first one, and ended layers
+	// aren't relevant anymore.
+	var layers []*scheduleLayerPayload
+	for i := len(schedule.ScheduleLayers) - 1; i >= 0; i-- {
+		l := schedule.ScheduleLayers[i]
+		if l.End != nil && *l.End != "" {
+			end, err := util.TimeToUTC(*l.End)
+			if err != nil {
+				diags.AddError("Error reading PagerDuty schedule layer", err.Error())
+				continue
+			}
+			if time.Now().UTC().After(end) {
+				continue
+			}
+		}
+		layers = append(layers, l)
+	}
+
+	elements := make([]attr.Value, 0, len(layers))
+	for i, l := range layers {
+		var p scheduleLayerModel
+		if i < len(priorLayers) {
+			p = priorLayers[i]
+		}
+
+		start := types.StringValue(l.Start)
+		if isKnownValue(p.Start) && scheduleLayerStartEqual(p.Start.ValueString(), l.Start) {
+			start = p.Start
+		}
+		end := types.StringNull()
+		if l.End != nil && *l.End != "" {
+			end = types.StringValue(*l.End)
+			if isKnownValue(p.End) && semanticallyEqualTime(p.End.ValueString(), *l.End) {
+				end = p.End
+			}
+		}
+		rvs := types.StringValue(l.RotationVirtualStart)
+		if isKnownValue(p.RotationVirtualStart) && semanticallyEqualTime(p.RotationVirtualStart.ValueString(), l.RotationVirtualStart) {
+			rvs = p.RotationVirtualStart
+		}
+
+		users := make([]attr.Value, 0, len(l.Users))
+		for _, u := range l.Users {
+			users = append(users, types.StringValue(u.User.ID))
+		}
+
+		var priorRestrictions []scheduleRestrictionModel
+		if !p.Restriction.IsNull() && !p.Restriction.IsUnknown() {
+			diags.Append(p.Restriction.ElementsAs(ctx, &priorRestrictions, false)...)
+		}
+		restrictions := make([]attr.Value, 0, len(l.Restrictions))
+		for ri, rs := range l.Restrictions {
+			// A start_day_of_week of 0 is the same as leaving it unset.
+			startDayOfWeek := types.Int64Null()
+			if rs.StartDayOfWeek > 0 {
+				startDayOfWeek = types.Int64Value(int64(rs.StartDayOfWeek))
+			} else if ri < len(priorRestrictions) && priorRestrictions[ri].StartDayOfWeek.ValueInt64() == 0 {
+				startDayOfWeek = priorRestrictions[ri].StartDayOfWeek
+			}
+			restrictions = append(restrictions, types.ObjectValueMust(scheduleRestrictionObjectType.AttrTypes, map[string]attr.Value{
+				"type":              types.StringValue(rs.Type),
+				"start_time_of_day": types.StringValue(rs.StartTimeOfDay),
+				"start_day_of_week": startDayOfWeek,
+				"duration_seconds":  types.Int64Value(int64(rs.DurationSeconds)),
+			}))
+		}
+		restriction := types.ListNull(scheduleRestrictionObjectType)
+		if len(restrictions) > 0 {
+			restriction = types.ListValueMust(scheduleRestrictionObjectType, restrictions)
+		}
+
+		elements = append(elements, types.ObjectValueMust(scheduleLayerObjectType.AttrTypes, map[string]attr.Value{
+			"id":                           types.StringValue(l.ID),
+			"name":                         types.StringValue(l.Name),
+			"start":                        start,
+			"end":                          end,
+			"rotation_virtual_start":       rvs,
+			"rotation_turn_length_seconds": types.Int64Value(int64(l.RotationTurnLengthSeconds)),
+			"users":                        types.ListValueMust(types.StringType, users),
+			"rendered_coverage_percentage": types.StringValue(util.RenderRoundedPercentage(l.RenderedCoveragePercentage)),
+			"restriction":                  restriction,
+		}))
+	}
+	model.Layer = types.ListValueMust(scheduleLayerObjectType, elements)
+
+	return model
+}
+
+// scheduleLayerStartEqual tells whether the start of a layer is unchanged.
+// The API moves a start in the past to the current time, so two starts in the
+// past are equal.
+func scheduleLayerStartEqual(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	now := time.Now()
+	return ta.Equal(tb) || (ta.Before(now) && tb.Before(now))
+}
+
+// scheduleTimeValidator checks that a value is a RFC3339 time, set to a full
+// minute when `fullMinute` is true.
+type scheduleTimeValidator struct {
+	fullMinute bool
+}
+
+var _ validator.String = scheduleTimeValidator{}
+
+func (v scheduleTimeValidator) Description(_ context.Context) string {
+	if v.fullMinute {
+		return "value must be a RFC3339 time set to a full minute"
+	}
+	return "value must be a RFC3339 time"
+}
+
+func (v scheduleTimeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v scheduleTimeValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schedule time", util.GenErrorTimeFormatRFC339(value, req.Path.String()).Error())
+		return
+	}
+	if v.fullMinute && t.Second() > 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid schedule time", fmt.Sprintf("please set the time %s to a full minute, e.g. 11:23:00, not 11:23:05", value))
+	}
+}
+
+// scheduleRestrictionValidator checks that only weekly restrictions, and all
+// of them, start on a day of the week, and that daily restrictions last less
+// than a day.
+type scheduleRestrictionValidator struct{}
+
+var _ validator.Object = scheduleRestrictionValidator{}
+
+func (v scheduleRestrictionValidator) Description(_ context.Context) string {
+	return "start_day_of_week must be set for weekly restrictions only, and daily restrictions must be shorter than a day"
+}
+
+func (v scheduleRestrictionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v scheduleRestrictionValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	attrs := req.ConfigValue.Attributes()
+	restrictionType := objectStringAttribute(attrs, "type")
+	startDayOfWeek, _ := attrs["start_day_of_week"].(types.Int64)
+	durationSeconds, _ := attrs["duration_seconds"].(types.Int64)
+	if !isKnownValue(restrictionType) || startDayOfWeek.IsUnknown() {
+		return
+	}
+
+	switch restrictionType.ValueString() {
+	case "daily_restriction":
+		if startDayOfWeek.ValueInt64() != 0 {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("start_day_of_week"), "Invalid schedule restriction",
+				"start_day_of_week must only be set for a weekly_restriction schedule restriction type")
+		}
+		if !durationSeconds.IsUnknown() && durationSeconds.ValueInt64() >= 24*3600 {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("duration_seconds"), "Invalid schedule restriction",
+				"duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
+		}
+	case "weekly_restriction":
+		if startDayOfWeek.ValueInt64() == 0 {
+			resp.Diagnostics.AddAttributeError(req.Path.AtName("start_day_of_week"), "Invalid schedule restriction",
+				"start_day_of_week must be set for a weekly_restriction schedule restriction type")
+		}
+	}
+}
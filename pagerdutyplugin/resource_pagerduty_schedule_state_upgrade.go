@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState upgrades the state written by the SDKv2 implementation of
+// pagerduty_schedule, which stored unset strings and numbers as zero values.
+func (r *resourceSchedule) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   resourceScheduleSchemaV0(),
+			StateUpgrader: upgradeScheduleStateV0,
+		},
+	}
+}
+
+func resourceScheduleSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Optional: true},
+			"time_zone":   schema.StringAttribute{Required: true},
+			"overflow":    schema.BoolAttribute{Optional: true},
+			"description": schema.StringAttribute{Optional: true},
+			"teams":       schema.ListAttribute{Optional: true, ElementType: types.StringType},
+			"final_schedule": schema.ListAttribute{
+				Computed:    true,
+				ElementType: scheduleFinalScheduleObjectType,
+			},
+			"deletion_protection": schema.BoolAttribute{Optional: true, Computed: true},
+			"layer": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"id":                           schema.StringAttribute{Computed: true},
+					"name":                         schema.StringAttribute{Optional: true, Computed: true},
+					"start":                        schema.StringAttribute{Required: true},
+					"end":                          schema.StringAttribute{Optional: true},
+					"rotation_virtual_start":       schema.StringAttribute{Required: true},
+					"rotation_turn_length_seconds": schema.Int64Attribute{Required: true},
+					"users":                        schema.ListAttribute{Required: true, ElementType: types.StringType},
+					"rendered_coverage_percentage": schema.StringAttribute{Computed: true},
+					"restriction": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+							"type":              schema.StringAttribute{Required: true},
+							"start_time_of_day": schema.StringAttribute{Required: true},
+							"start_day_of_week": schema.Int64Attribute{Optional: true},
+							"duration_seconds":  schema.Int64Attribute{Required: true},
+						}},
+					},
+				}},
+			},
+		},
+	}
+}
+
+func upgradeScheduleStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var model resourceScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.Name = emptyStringToNull(model.Name)
+	model.Teams = emptyListToNull(model.Teams)
+	if model.DeletionProtection.IsNull() {
+		model.DeletionProtection = types.BoolValue(false)
+	}
+	if model.FinalSchedule.IsNull() {
+		model.FinalSchedule = types.ListValueMust(scheduleFinalScheduleObjectType, []attr.Value{})
+	}
+
+	diags := &resp.Diagnostics
+
+	var layers []scheduleLayerModel
+	diags.Append(model.Layer.ElementsAs(ctx, &layers, false)...)
+	elements := make([]attr.Value, 0, len(layers))
+	for _, l := range layers {
+		var restrictions []scheduleRestrictionModel
+		if !l.Restriction.IsNull() {
+			diags.Append(l.Restriction.ElementsAs(ctx, &restrictions, false)...)
+		}
+		restrictionElements := make([]attr.Value, 0, len(restrictions))
+		for _, rs := range restrictions {
+			restrictionElements = append(restrictionElements, types.ObjectValueMust(scheduleRestrictionObjectType.AttrTypes, map[string]attr.Value{
+				"type":              rs.Type,
+				"start_time_of_day": rs.StartTimeOfDay,
+				"start_day_of_week": zeroInt64ToNull(rs.StartDayOfWeek),
+				"duration_seconds":  rs.DurationSeconds,
+			}))
+		}
+		restriction := types.ListNull(scheduleRestrictionObjectType)
+		if len(restrictionElements) > 0 {
+			restriction = types.ListValueMust(scheduleRestrictionObjectType, restrictionElements)
+		}
+
+		elements = append(elements, types.ObjectValueMust(scheduleLayerObjectType.AttrTypes, map[string]attr.Value{
+			"id":                           l.ID,
+			"name":                         l.Name,
+			"start":                        l.Start,
+			"end":                          emptyStringToNull(l.End),
+			"rotation_virtual_start":       l.RotationVirtualStart,
+			"rotation_turn_length_seconds": l.RotationTurnLengthSeconds,
+			"users":                        l.Users,
+			"rendered_coverage_percentage": l.RenderedCoveragePercentage,
+			"restriction":                  restriction,
+		}))
+	}
+	model.Layer = types.ListValueMust(scheduleLayerObjectType, elements)
+
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -9,10 +10,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -23,17 +27,8 @@ func init() {
 }
 
 func testSweepSchedule(region string) error {
-	config, err := sharedConfigForRegion(region)
-	if err != nil {
-		return err
-	}
-
-	client, err := config.Client()
-	if err != nil {
-		return err
-	}
-
-	resp, _, err := client.Schedules.List(&pagerduty.ListSchedulesOptions{})
+	ctx := context.Background()
+	resp, err := testAccProvider.client.ListSchedulesWithContext(ctx, pagerduty.ListSchedulesOptions{})
 	if err != nil {
 		return err
 	}
@@ -41,7 +36,7 @@ func testSweepSchedule(region string) error {
 	for _, schedule := range resp.Schedules {
 		if strings.HasPrefix(schedule.Name, "test") || strings.HasPrefix(schedule.Name, "tf-") {
 			log.Printf("Destroying schedule %s (%s)", schedule.Name, schedule.ID)
-			if _, err := client.Schedules.Delete(schedule.ID); err != nil {
+			if err := testAccProvider.client.DeleteScheduleWithContext(ctx, schedule.ID); err != nil {
 				return err
 			}
 		}
@@ -50,21 +45,63 @@ func testSweepSchedule(region string) error {
 	return nil
 }
 
+func TestResourcePagerDutyScheduleUpgradeStateV0(t *testing.T) {
+	// State as written by the SDKv2 implementation of the resource.
+	rawState := []byte(`{
+		"id": "PSCHEDU",
+		"name": "foo",
+		"time_zone": "America/New_York",
+		"overflow": null,
+		"description": "Managed by Terraform",
+		"teams": [],
+		"final_schedule": [{"name": "Final Schedule", "rendered_coverage_percentage": "100.00"}],
+		"deletion_protection": null,
+		"layer": [{
+			"id": "PLAYER1",
+			"name": "Layer 1",
+			"start": "2024-01-01T00:00:00-05:00",
+			"end": "",
+			"rotation_virtual_start": "2024-01-01T00:00:00-05:00",
+			"rotation_turn_length_seconds": 86400,
+			"users": ["PUSER01"],
+			"rendered_coverage_percentage": "100.00",
+			"restriction": [
+				{"type": "daily_restriction", "start_time_of_day": "08:00:00", "start_day_of_week": 0, "duration_seconds": 32400},
+				{"type": "weekly_restriction", "start_time_of_day": "08:00:00", "start_day_of_week": 1, "duration_seconds": 32400}
+			]
+		}]
+	}`)
+
+	state := testUpgradeResourceStateV0(t, "pagerduty_schedule", rawState)
+
+	layer := tftypes.NewAttributePath().WithAttributeName("layer").WithElementKeyInt(0)
+	testCheckUpgradedState(t, state, map[*tftypes.AttributePath]interface{}{
+		tftypes.NewAttributePath().WithAttributeName("name"):                                                          "foo",
+		tftypes.NewAttributePath().WithAttributeName("teams"):                                                         nil,
+		tftypes.NewAttributePath().WithAttributeName("deletion_protection"):                                           false,
+		tftypes.NewAttributePath().WithAttributeName("final_schedule").WithElementKeyInt(0).WithAttributeName("name"): "Final Schedule",
+		layer.WithAttributeName("end"):                                                                                nil,
+		layer.WithAttributeName("rotation_turn_length_seconds"):                                                       int64(86400),
+		layer.WithAttributeName("restriction").WithElementKeyInt(0).WithAttributeName("start_day_of_week"):            nil,
+		layer.WithAttributeName("restriction").WithElementKeyInt(1).WithAttributeName("start_day_of_week"):            int64(1),
+	})
+}
+
 func TestAccPagerDutySchedule_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	scheduleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	startWrongFormated := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC1123)
-	startNotRounded := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Add(5 * time.Second).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	startWrongFormated := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC1123)
+	startNotRounded := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Add(5 * time.Second).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleConfig(username, email, schedule, location, start, rotationVirtualStart),
@@ -147,15 +184,15 @@ func TestAccPagerDutyScheduleWithTeams_Basic(t *testing.T) {
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	scheduleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	teamUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleWithTeamsConfig(username, email, schedule, location, start, rotationVirtualStart, team),
@@ -210,13 +247,13 @@ func TestAccPagerDutySchedule_BasicWithExternalDestroyHandling(t *testing.T) {
 	email := fmt.Sprintf("%s@foo.test", username)
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleConfig(username, email, schedule, location, start, rotationVirtualStart),
@@ -242,15 +279,15 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependant(t *testing.T) {
 	email := fmt.Sprintf("%s@foo.test", username)
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	escalationPolicy := fmt.Sprintf("ts-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantConfig(username, email, schedule, location, start, rotationVirtualStart, team, escalationPolicy),
@@ -281,8 +318,8 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependantWithOneLayer(t *
 	email := fmt.Sprintf("%s@foo.test", username)
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	escalationPolicy1 := fmt.Sprintf("ts-%s", acctest.RandString(5))
 	escalationPolicy2 := fmt.Sprintf("ts-%s", acctest.RandString(5))
@@ -292,8 +329,8 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependantWithOneLayer(t *
 			testAccPreCheck(t)
 			testAccPreCheckScheduleUsedByEPWithOneLayer(t)
 		},
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantWithOneLayerConfig(username, email, schedule, location, start, rotationVirtualStart, team, escalationPolicy1),
@@ -311,7 +348,7 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependantWithOneLayer(t *
 			// one configured layer prompts the expected error.
 			{
 				Config:      testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantConfigUpdated(username, email, team, escalationPolicy1),
-				ExpectError: regexp.MustCompile("It is not possible to continue with the destruction of the Schedule \".*\", because it is being used by the Escalation Policy \".*\" which has only one layer configured"),
+				ExpectError: regexp.MustCompile("It is not possible to continue with the destruction of the Schedule \".*\", because it is being used by Escalation Policies which would be left without any target"),
 			},
 			{
 				Config: testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantWithMultipleLayersUsingTheSameScheduleAsTargetConfig(username, email, schedule, location, start, rotationVirtualStart, team, escalationPolicy1),
@@ -330,7 +367,7 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependantWithOneLayer(t *
 			// Schedule try to be deleted
 			{
 				Config:      testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantWithMultipleLayersUsingTheSameScheduleAsTargetConfigUpdated(username, email, team, escalationPolicy1),
-				ExpectError: regexp.MustCompile("It is not possible to continue with the destruction of the Schedule \".*\", because it is being used by the Escalation Policy \".*\" which has only one layer configured"),
+				ExpectError: regexp.MustCompile("It is not possible to continue with the destruction of the Schedule \".*\", because it is being used by Escalation Policies which would be left without any target"),
 			},
 			{
 				Config: testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantMultipleWithOneLayerConfig(username, email, schedule, location, start, rotationVirtualStart, team, escalationPolicy1, escalationPolicy2),
@@ -348,7 +385,7 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependantWithOneLayer(t *
 			// Policies with one configured layer prompts the expected error.
 			{
 				Config:      testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantMultipleWithOneLayerConfigUpdated(username, email, team, escalationPolicy1, escalationPolicy2),
-				ExpectError: regexp.MustCompile("It is not possible to continue with the destruction of the Schedule \".*\", because it is being used by multiple Escalation Policies which have only one layer configured."),
+				ExpectError: regexp.MustCompile("It is not possible to continue with the destruction of the Schedule \".*\", because it is being used by Escalation Policies which would be left without any target"),
 			},
 		},
 	})
@@ -361,8 +398,8 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependantWithOpenIncident
 	email := fmt.Sprintf("%s@foo.test", username)
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	escalationPolicy1 := fmt.Sprintf("ts-%s", acctest.RandString(5))
 	escalationPolicy2 := fmt.Sprintf("ts-%s", acctest.RandString(5))
@@ -372,9 +409,9 @@ func TestAccPagerDutyScheduleWithTeams_EscalationPolicyDependantWithOpenIncident
 	p_unrelated_incident_id := &unrelated_incident_id
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleWithTeamsEscalationPolicyDependantWithOpenIncidentConfig(username, email, schedule, location, start, rotationVirtualStart, team, escalationPolicy1, service1),
@@ -449,8 +486,8 @@ func TestAccPagerDutySchedule_EscalationPolicyDependantWithOpenIncidents(t *test
 	schedule1 := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	schedule2 := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 	escalationPolicy1 := fmt.Sprintf("ts-%s", acctest.RandString(5))
 	escalationPolicy2 := fmt.Sprintf("ts-%s", acctest.RandString(5))
 	incident_id := ""
@@ -459,9 +496,9 @@ func TestAccPagerDutySchedule_EscalationPolicyDependantWithOpenIncidents(t *test
 	p_unrelated_incident_id := &unrelated_incident_id
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleEscalationPolicyDependantWithOpenIncidentConfig(username, email, schedule1, location, start, rotationVirtualStart, escalationPolicy1, service1),
@@ -542,13 +579,13 @@ func TestAccPagerDutyScheduleOverflow_Basic(t *testing.T) {
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	scheduleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "America/New_York"
-	start := timeNowInLoc(location).Add(30 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(30 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(30 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(30 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleOverflowConfig(username, email, schedule, location, start, rotationVirtualStart),
@@ -572,13 +609,13 @@ func TestAccPagerDutySchedule_BasicWeek(t *testing.T) {
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	scheduleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "Australia/Melbourne"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleConfigWeek(username, email, schedule, location, start, rotationVirtualStart),
@@ -633,14 +670,14 @@ func TestAccPagerDutySchedule_Multi(t *testing.T) {
 	email := fmt.Sprintf("%s@foo.test", username)
 	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	location := "Europe/Berlin"
-	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	end := timeNowInLoc(location).Add(72 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
-	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	start := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	end := util.TimeNowInLoc(location).Add(72 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := util.TimeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyScheduleConfigMulti(username, email, schedule, location, start, rotationVirtualStart, end),
@@ -731,19 +768,146 @@ func TestAccPagerDutySchedule_Multi(t *testing.T) {
 	})
 }
 
-func testAccCheckPagerDutyScheduleDestroy(s *terraform.State) error {
-	client, _ := testAccProvider.Meta().(*Config).Client()
-	for _, r := range s.RootModule().Resources {
-		if r.Type != "pagerduty_schedule" {
-			continue
-		}
+func TestAccPagerDutySchedule_DailyRestriction(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	scheduleUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := time.Now().UTC().Add(24 * time.Hour).Round(time.Hour).Format(time.RFC3339)
+	resourceName := "pagerduty_schedule.foo"
 
-		if _, _, err := client.Schedules.Get(r.Primary.ID, &pagerduty.GetScheduleOptions{}); err == nil {
-			return fmt.Errorf("Schedule still exists")
-		}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyScheduleDailyRestrictionConfig(username, email, schedule, start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", schedule),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "America/New_York"),
+					resource.TestCheckResourceAttr(resourceName, "layer.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "layer.0.start", start),
+					resource.TestCheckResourceAttr(resourceName, "layer.0.restriction.0.type", "daily_restriction"),
+					resource.TestCheckNoResourceAttr(resourceName, "layer.0.restriction.0.start_day_of_week"),
+					resource.TestCheckResourceAttrSet(resourceName, "final_schedule.0.rendered_coverage_percentage"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyScheduleDailyRestrictionConfig(username, email, scheduleUpdated, start),
+				Check:  resource.TestCheckResourceAttr(resourceName, "name", scheduleUpdated),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overflow", "layer.0.start", "layer.0.rotation_virtual_start"},
+			},
+		},
+	})
+}
 
-	}
-	return nil
+func TestAccPagerDutySchedule_Validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyScheduleRestrictionConfig("weekly_restriction", "", 3600),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`start_day_of_week must be set for a weekly_restriction schedule restriction type`),
+			},
+			{
+				Config:      testAccCheckPagerDutyScheduleRestrictionConfig("daily_restriction", "start_day_of_week = 1", 3600),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`start_day_of_week must only be set for a weekly_restriction schedule restriction type`),
+			},
+			{
+				Config:      testAccCheckPagerDutyScheduleRestrictionConfig("daily_restriction", "", 24*3600),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be shorter than a day`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutySchedule_SDKv2Compatibility(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := time.Now().UTC().Add(24 * time.Hour).Round(time.Hour).Format(time.RFC3339)
+	resourceName := "pagerduty_schedule.foo"
+	config := testAccCheckPagerDutyScheduleDailyRestrictionConfig(username, email, schedule, start)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check:             resource.TestCheckResourceAttr(resourceName, "name", schedule),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", schedule),
+					resource.TestCheckNoResourceAttr(resourceName, "layer.0.end"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyScheduleDailyRestrictionConfig(username, email, schedule, start string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule" "foo" {
+  name      = "%s"
+  time_zone = "America/New_York"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[4]s"
+    rotation_virtual_start       = "%[4]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+
+    restriction {
+      type              = "daily_restriction"
+      start_time_of_day = "08:00:00"
+      duration_seconds  = 32400
+    }
+  }
+}
+`, username, email, schedule, start)
+}
+
+func testAccCheckPagerDutyScheduleRestrictionConfig(restrictionType, startDayOfWeek string, durationSeconds int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_schedule" "foo" {
+  time_zone = "America/New_York"
+
+  layer {
+    start                        = "2030-01-01T00:00:00Z"
+    rotation_virtual_start       = "2030-01-01T00:00:00Z"
+    rotation_turn_length_seconds = 86400
+    users                        = ["PUSER01"]
+
+    restriction {
+      type              = "%s"
+      start_time_of_day = "08:00:00"
+      duration_seconds  = %d
+      %s
+    }
+  }
+}
+`, restrictionType, durationSeconds, startDayOfWeek)
 }
 
 func testAccCheckPagerDutyScheduleExists(n string) resource.TestCheckFunc {
@@ -756,9 +920,8 @@ func testAccCheckPagerDutyScheduleExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Schedule ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		found, _, err := client.Schedules.Get(rs.Primary.ID, &pagerduty.GetScheduleOptions{})
+		ctx := context.Background()
+		found, err := testAccProvider.client.GetScheduleWithContext(ctx, rs.Primary.ID, pagerduty.GetScheduleOptions{})
 		if err != nil {
 			return err
 		}
@@ -781,9 +944,8 @@ func testAccCheckPagerDutyScheduleNoExists(n string) resource.TestCheckFunc {
 			return nil
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		found, _, err := client.Schedules.Get(rs.Primary.ID, &pagerduty.GetScheduleOptions{})
+		ctx := context.Background()
+		found, err := testAccProvider.client.GetScheduleWithContext(ctx, rs.Primary.ID, pagerduty.GetScheduleOptions{})
 		if err != nil {
 			return err
 		}
@@ -806,13 +968,8 @@ func testAccExternallyDestroySchedule(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Schedule ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-		_, err := client.Schedules.Delete(rs.Primary.ID)
-		if err != nil {
-			return err
-		}
-
-		return nil
+		ctx := context.Background()
+		return testAccProvider.client.DeleteScheduleWithContext(ctx, rs.Primary.ID)
 	}
 }
 
@@ -1594,21 +1751,19 @@ func testAccCheckPagerDutyScheduleOpenIncidentOnService(p *string, sn, epn strin
 			return fmt.Errorf("No Escalation Policy ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		incident := &pagerduty.Incident{
-			Type:  "incident",
+		ctx := context.Background()
+		incident := &pagerduty.CreateIncidentOptions{
 			Title: fmt.Sprintf("tf-%s", acctest.RandString(5)),
-			Service: &pagerduty.ServiceReference{
+			Service: &pagerduty.APIReference{
 				ID:   rs.Primary.ID,
 				Type: "service_reference",
 			},
-			EscalationPolicy: &pagerduty.EscalationPolicyReference{
+			EscalationPolicy: &pagerduty.APIReference{
 				ID:   rep.Primary.ID,
 				Type: "escalation_policy_reference",
 			},
 		}
-		resp, _, err := client.Incidents.Create(incident)
+		resp, err := testAccProvider.client.CreateIncidentWithContext(ctx, "", incident)
 		if err != nil {
 			return err
 		}
@@ -1621,23 +1776,13 @@ func testAccCheckPagerDutyScheduleOpenIncidentOnService(p *string, sn, epn strin
 
 func testAccPagerDutyScheduleResolveIncident(p *string, epn string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		incident, _, err := client.Incidents.Get(*p)
-		if err != nil {
-			return err
-		}
+		ctx := context.Background()
 
 		// marking incident as resolved
-		incident.Status = "resolved"
-		_, _, err = client.Incidents.ManageIncidents([]*pagerduty.Incident{
-			incident,
-		}, &pagerduty.ManageIncidentsOptions{})
-		if err != nil {
-			return err
-		}
-
-		return nil
+		_, err := testAccProvider.client.ManageIncidentsWithContext(ctx, "", []pagerduty.ManageIncidentsOptions{
+			{ID: *p, Status: "resolved"},
+		})
+		return err
 	}
 }
 
@@ -0,0 +1,1086 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type resourceService struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure      = (*resourceService)(nil)
+	_ resource.ResourceWithImportState    = (*resourceService)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceService)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceService)(nil)
+	_ resource.ResourceWithUpgradeState   = (*resourceService)(nil)
+)
+
+func (r *resourceService) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_service"
+}
+
+// serviceTimeoutRegexp matches the timeouts of a service, given in seconds
+// or disabled with "null".
+var serviceTimeoutRegexp = regexp.MustCompile(`^(null|\d+)$`)
+
+func (r *resourceService) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	urgencyTypeBlock := schema.ListNestedBlock{
+		Validators: []validator.List{listvalidator.SizeAtMost(1)},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type":    schema.StringAttribute{Optional: true},
+				"urgency": schema.StringAttribute{Optional: true},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{validate.IsAllowedString(util.NoNonPrintableChars)},
+			},
+			"html_url": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Managed by Terraform"),
+			},
+			"alert_creation": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.OneOf("create_alerts_and_incidents", "create_incidents"),
+				},
+			},
+			"alert_grouping": schema.StringAttribute{
+				Optional:           true,
+				Computed:           true,
+				DeprecationMessage: "Use `alert_grouping_parameters.type`",
+				PlanModifiers:      []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.OneOf("time", "intelligent", "rules"),
+					stringvalidator.ConflictsWith(path.MatchRoot("alert_grouping_parameters")),
+				},
+			},
+			"alert_grouping_timeout": schema.StringAttribute{
+				Optional:           true,
+				Computed:           true,
+				DeprecationMessage: "Use `alert_grouping_parameters.config.timeout`",
+				PlanModifiers:      []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("alert_grouping_parameters")),
+				},
+			},
+			"auto_resolve_timeout": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("14400"),
+				Validators: []validator.String{stringvalidator.RegexMatches(serviceTimeoutRegexp, `must be a number of seconds or "null"`)},
+			},
+			"acknowledgement_timeout": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("1800"),
+				Validators: []validator.String{stringvalidator.RegexMatches(serviceTimeoutRegexp, `must be a number of seconds or "null"`)},
+			},
+			"last_incident_timestamp": schema.StringAttribute{
+				Computed:           true,
+				DeprecationMessage: "The last_incident_timestamp attribute is no longer set as it caused persistent drift in plan output. Use data.pagerduty_service if you need this value.",
+				PlanModifiers:      []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed:           true,
+				DeprecationMessage: "The status attribute is no longer set as it caused persistent drift in plan output. Use data.pagerduty_service if you need this value.",
+				PlanModifiers:      []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"escalation_policy": schema.StringAttribute{Required: true},
+			"type": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"response_play": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"alert_grouping_parameters": schema.ListNestedBlock{
+				DeprecationMessage: "Use a resource `pagerduty_alert_grouping_setting` instead.\nFollow the migration guide at https://registry.terraform.io/providers/PagerDuty/pagerduty/latest/docs/resources/alert_grouping_setting#migration-from-alert_grouping_parameters",
+				Validators:         []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{stringvalidator.OneOf("time", "intelligent", "content_based")},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"config": schema.ListNestedBlock{
+							Validators: []validator.List{listvalidator.SizeAtMost(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"timeout": schema.Int64Attribute{Optional: true},
+									"fields": schema.ListAttribute{
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+									"aggregate": schema.StringAttribute{
+										Optional:   true,
+										Validators: []validator.String{stringvalidator.OneOf("all", "any")},
+									},
+									"time_window": schema.Int64Attribute{
+										Optional:      true,
+										Computed:      true,
+										PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+										Validators: []validator.Int64{
+											int64validator.Any(int64validator.Between(300, 3600), int64validator.OneOf(86400)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"auto_pause_notifications_parameters": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+						},
+						"timeout": schema.Int64Attribute{
+							Optional:      true,
+							Computed:      true,
+							PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+							Validators:    []validator.Int64{int64validator.OneOf(120, 180, 300, 600, 900)},
+						},
+					},
+				},
+			},
+			"incident_urgency_rule": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type":    schema.StringAttribute{Optional: true},
+						"urgency": schema.StringAttribute{Optional: true},
+					},
+					Blocks: map[string]schema.Block{
+						"during_support_hours":  urgencyTypeBlock,
+						"outside_support_hours": urgencyTypeBlock,
+					},
+				},
+			},
+			"support_hours": schema.ListNestedBlock{
+				Validators: []validator.List{listvalidator.SizeAtMost(1)},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{Optional: true},
+						"time_zone": schema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{validate.ValidTimeZone()},
+						},
+						"start_time": schema.StringAttribute{Optional: true},
+						"end_time":   schema.StringAttribute{Optional: true},
+						"days_of_week": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Validators:  []validator.List{listvalidator.SizeAtMost(7)},
+						},
+					},
+				},
+			},
+			"scheduled_actions": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type":       schema.StringAttribute{Optional: true},
+						"to_urgency": schema.StringAttribute{Optional: true},
+					},
+					Blocks: map[string]schema.Block{
+						"at": schema.ListNestedBlock{
+							Validators: []validator.List{listvalidator.SizeAtMost(1)},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{Optional: true},
+									"name": schema.StringAttribute{Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceService) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceServiceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateServiceUrgencyConfig(ctx, &model, &resp.Diagnostics)
+
+	agp, ok := firstListElement[serviceAlertGroupingParametersModel](ctx, model.AlertGroupingParameters, &resp.Diagnostics)
+	if !ok || agp.Type.IsUnknown() {
+		return
+	}
+	config, _ := firstListElement[serviceAlertGroupingConfigModel](ctx, agp.Config, &resp.Diagnostics)
+	agpType := agp.Type.ValueString()
+	configPath := path.Root("alert_grouping_parameters").AtListIndex(0).AtName("config").AtListIndex(0)
+
+	hasContentFields := !config.Aggregate.IsNull() || len(config.Fields.Elements()) > 0
+	if agpType == "content_based" && !config.Aggregate.IsUnknown() && !config.Fields.IsUnknown() && (config.Aggregate.IsNull() || len(config.Fields.Elements()) == 0) {
+		resp.Diagnostics.AddAttributeError(configPath, "Invalid configuration",
+			`When using Alert grouping parameters configuration of type "content_based" is in use, attributes "aggregate" and "fields" are required`)
+	}
+	if agpType == "" {
+		return
+	}
+	if config.TimeWindow.ValueInt64() == 86400 && agpType != "content_based" {
+		resp.Diagnostics.AddAttributeError(configPath.AtName("time_window"), "Invalid configuration",
+			`Alert grouping parameters configuration attribute "time_window" with a value of 86400 is only supported by "content-based" type Alert Grouping`)
+	}
+	if hasContentFields && agpType != "content_based" {
+		resp.Diagnostics.AddAttributeError(configPath, "Invalid configuration",
+			`Alert grouping parameters configuration attributes "aggregate" and "fields" are only supported by "content_based" type Alert Grouping`)
+	}
+	if config.Timeout.ValueInt64() > 0 && agpType != "time" {
+		resp.Diagnostics.AddAttributeError(configPath.AtName("timeout"), "Invalid configuration",
+			`Alert grouping parameters configuration attribute "timeout" is only supported by "time" type Alert Grouping`)
+	}
+	if config.TimeWindow.ValueInt64() > 300 && agpType != "intelligent" && agpType != "content_based" {
+		resp.Diagnostics.AddAttributeError(configPath.AtName("time_window"), "Invalid configuration",
+			`Alert grouping parameters configuration attribute "time_window" is only supported by "intelligent" and "content-based" type Alert Grouping`)
+	}
+}
+
+func (r *resourceService) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if deletionProtection.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), planDeletionProtection(r.config))...)
+	}
+
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var supportHours, incidentUrgencyRule types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("support_hours"), &supportHours)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("incident_urgency_rule"), &incidentUrgencyRule)...)
+	var abilities []string
+	if len(supportHours.Elements()) > 0 {
+		abilities = append(abilities, "service_support_hours")
+	}
+	if len(incidentUrgencyRule.Elements()) > 0 {
+		abilities = append(abilities, "urgencies")
+	}
+	checkPreflight(r.config, "pagerduty_service", "services.write", abilities, &resp.Diagnostics)
+}
+
+func (r *resourceService) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceServiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan := buildServicePayload(ctx, &model, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Creating PagerDuty service %s", plan.Name)
+
+	var created servicePayloadEnvelope
+	err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPost, "/services", servicePayloadEnvelope{Service: plan}, &created)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error creating PagerDuty service %s", plan.Name), err)
+		return
+	}
+
+	// We wait for internal subsystem to sync. Otherwise fields like
+	// alert_grouping_parameters will return empty.
+	time.Sleep(500 * time.Millisecond)
+
+	service, err := r.requestGetService(ctx, created.Service.ID, true)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty service %s", created.Service.ID), err.Error())
+		return
+	}
+	model = flattenService(ctx, service, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceService) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceServiceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Reading PagerDuty service %s", state.ID)
+
+	service, err := r.requestGetService(ctx, state.ID.ValueString(), false)
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			log.Printf("[WARN] Removing %s because it's gone", state.ID)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty service %s", state.ID), err.Error())
+		return
+	}
+
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	state = flattenService(ctx, service, state, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceService) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceServiceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing deletion_protection alone doesn't need any request.
+	onlyDeletionProtection := req.Plan
+	resp.Diagnostics.Append(onlyDeletionProtection.SetAttribute(ctx, path.Root("deletion_protection"), state.DeletionProtection)...)
+	if onlyDeletionProtection.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		return
+	}
+
+	plan := buildServicePayload(ctx, &model, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+	log.Printf("[INFO] Updating PagerDuty service %s", id)
+
+	err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodPut, "/services/"+id, servicePayloadEnvelope{Service: plan}, nil)
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error updating PagerDuty service %s", id), err)
+		return
+	}
+
+	if len(state.AlertGroupingParameters.Elements()) > 0 && len(model.AlertGroupingParameters.Elements()) == 0 {
+		resp.Diagnostics.AddWarning(
+			"Deleting alert_grouping_parameters might delete an alert_grouping_setting depending on it, please run terraform plan and apply again after these changes are applied in order to ensure Alert Grouping Settings are up and running",
+			"",
+		)
+	}
+
+	service, err := r.requestGetService(ctx, id, false)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading PagerDuty service %s", id), err.Error())
+		return
+	}
+	model = flattenService(ctx, service, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceService) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	var deletionProtection types.Bool
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkDeletionProtection("pagerduty_service", id.ValueString(), deletionProtection, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Deleting PagerDuty service %s", id)
+
+	err := r.client.DeleteServiceWithContext(ctx, id.ValueString())
+	if err != nil && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting PagerDuty service %s", id), err.Error())
+		return
+	}
+
+	// giving the API time to catchup
+	time.Sleep(time.Second)
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceService) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *resourceService) requestGetService(ctx context.Context, id string, retryNotFound bool) (*servicePayload, error) {
+	var service servicePayloadEnvelope
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodGet, "/services/"+id+"?include%5B%5D=auto_pause_notifications_parameters", nil, &service)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			if !retryNotFound && util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return service.Service, nil
+}
+
+type resourceServiceModel struct {
+	ID                               types.String `tfsdk:"id"`
+	Name                             types.String `tfsdk:"name"`
+	HTMLURL                          types.String `tfsdk:"html_url"`
+	Description                      types.String `tfsdk:"description"`
+	AlertCreation                    types.String `tfsdk:"alert_creation"`
+	AlertGrouping                    types.String `tfsdk:"alert_grouping"`
+	AlertGroupingTimeout             types.String `tfsdk:"alert_grouping_timeout"`
+	AlertGroupingParameters          types.List   `tfsdk:"alert_grouping_parameters"`
+	AutoPauseNotificationsParameters types.List   `tfsdk:"auto_pause_notifications_parameters"`
+	AutoResolveTimeout               types.String `tfsdk:"auto_resolve_timeout"`
+	AcknowledgementTimeout           types.String `tfsdk:"acknowledgement_timeout"`
+	LastIncidentTimestamp            types.String `tfsdk:"last_incident_timestamp"`
+	CreatedAt                        types.String `tfsdk:"created_at"`
+	Status                           types.String `tfsdk:"status"`
+	EscalationPolicy                 types.String `tfsdk:"escalation_policy"`
+	IncidentUrgencyRule              types.List   `tfsdk:"incident_urgency_rule"`
+	SupportHours                     types.List   `tfsdk:"support_hours"`
+	ScheduledActions                 types.List   `tfsdk:"scheduled_actions"`
+	Type                             types.String `tfsdk:"type"`
+	ResponsePlay                     types.String `tfsdk:"response_play"`
+	DeletionProtection               types.Bool   `tfsdk:"deletion_protection"`
+}
+
+type serviceAlertGroupingParametersModel struct {
+	Type   types.String `tfsdk:"type"`
+	Config types.List   `tfsdk:"config"`
+}
+
+type serviceAlertGroupingConfigModel struct {
+	Timeout    types.Int64  `tfsdk:"timeout"`
+	Fields     types.List   `tfsdk:"fields"`
+	Aggregate  types.String `tfsdk:"aggregate"`
+	TimeWindow types.Int64  `tfsdk:"time_window"`
+}
+
+type serviceAutoPauseNotificationsParametersModel struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+type serviceIncidentUrgencyRuleModel struct {
+	Type                types.String `tfsdk:"type"`
+	Urgency             types.String `tfsdk:"urgency"`
+	DuringSupportHours  types.List   `tfsdk:"during_support_hours"`
+	OutsideSupportHours types.List   `tfsdk:"outside_support_hours"`
+}
+
+type serviceIncidentUrgencyTypeModel struct {
+	Type    types.String `tfsdk:"type"`
+	Urgency types.String `tfsdk:"urgency"`
+}
+
+type serviceSupportHoursModel struct {
+	Type       types.String `tfsdk:"type"`
+	TimeZone   types.String `tfsdk:"time_zone"`
+	StartTime  types.String `tfsdk:"start_time"`
+	EndTime    types.String `tfsdk:"end_time"`
+	DaysOfWeek types.List   `tfsdk:"days_of_week"`
+}
+
+type serviceScheduledActionModel struct {
+	Type      types.String `tfsdk:"type"`
+	ToUrgency types.String `tfsdk:"to_urgency"`
+	At        types.List   `tfsdk:"at"`
+}
+
+type serviceScheduledActionAtModel struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+}
+
+var (
+	serviceAlertGroupingConfigObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"timeout":     types.Int64Type,
+		"fields":      types.ListType{ElemType: types.StringType},
+		"aggregate":   types.StringType,
+		"time_window": types.Int64Type,
+	}}
+	serviceAlertGroupingParametersObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":   types.StringType,
+		"config": types.ListType{ElemType: serviceAlertGroupingConfigObjectType},
+	}}
+	serviceAutoPauseNotificationsParametersObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"enabled": types.BoolType,
+		"timeout": types.Int64Type,
+	}}
+	serviceIncidentUrgencyTypeObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":    types.StringType,
+		"urgency": types.StringType,
+	}}
+	serviceIncidentUrgencyRuleObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":                  types.StringType,
+		"urgency":               types.StringType,
+		"during_support_hours":  types.ListType{ElemType: serviceIncidentUrgencyTypeObjectType},
+		"outside_support_hours": types.ListType{ElemType: serviceIncidentUrgencyTypeObjectType},
+	}}
+	serviceSupportHoursObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":         types.StringType,
+		"time_zone":    types.StringType,
+		"start_time":   types.StringType,
+		"end_time":     types.StringType,
+		"days_of_week": types.ListType{ElemType: types.Int64Type},
+	}}
+	serviceScheduledActionAtObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type": types.StringType,
+		"name": types.StringType,
+	}}
+	serviceScheduledActionObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":       types.StringType,
+		"to_urgency": types.StringType,
+		"at":         types.ListType{ElemType: serviceScheduledActionAtObjectType},
+	}}
+)
+
+// servicePayload is a service as sent to and returned by the API. Unlike the
+// client library's, it can disable timeouts and clear support hours.
+type servicePayload struct {
+	ID                               string                                   `json:"id,omitempty"`
+	Type                             string                                   `json:"type,omitempty"`
+	Name                             string                                   `json:"name,omitempty"`
+	Description                      string                                   `json:"description,omitempty"`
+	HTMLURL                          string                                   `json:"html_url,omitempty"`
+	CreatedAt                        string                                   `json:"created_at,omitempty"`
+	AutoResolveTimeout               *int                                     `json:"auto_resolve_timeout"`
+	AcknowledgementTimeout           *int                                     `json:"acknowledgement_timeout"`
+	AlertCreation                    string                                   `json:"alert_creation,omitempty"`
+	AlertGrouping                    *string                                  `json:"alert_grouping,omitempty"`
+	AlertGroupingTimeout             *int                                     `json:"alert_grouping_timeout,omitempty"`
+	AlertGroupingParameters          *serviceAlertGroupingParameters          `json:"alert_grouping_parameters,omitempty"`
+	AutoPauseNotificationsParameters *serviceAutoPauseNotificationsParameters `json:"auto_pause_notifications_parameters,omitempty"`
+	EscalationPolicy                 *serviceReference                        `json:"escalation_policy,omitempty"`
+	IncidentUrgencyRule              *serviceIncidentUrgencyRule              `json:"incident_urgency_rule,omitempty"`
+	SupportHours                     **serviceSupportHours                    `json:"support_hours,omitempty"`
+	ScheduledActions                 *[]*serviceScheduledAction               `json:"scheduled_actions,omitempty"`
+	ResponsePlay                     *serviceReference                        `json:"response_play"`
+}
+
+type servicePayloadEnvelope struct {
+	Service *servicePayload `json:"service"`
+}
+
+type serviceReference struct {
+	ID   string `json:"id"`
+	Type string `json:"type,omitempty"`
+}
+
+type serviceAlertGroupingParameters struct {
+	Type   *string                     `json:"type"`
+	Config *serviceAlertGroupingConfig `json:"config,omitempty"`
+}
+
+type serviceAlertGroupingConfig struct {
+	Timeout    *int     `json:"timeout,omitempty"`
+	TimeWindow *int     `json:"time_window,omitempty"`
+	Aggregate  *string  `json:"aggregate,omitempty"`
+	Fields     []string `json:"fields,omitempty"`
+}
+
+type serviceAutoPauseNotificationsParameters struct {
+	Enabled bool `json:"enabled"`
+	Timeout *int `json:"timeout"`
+}
+
+type serviceIncidentUrgencyRule struct {
+	Type                string                      `json:"type,omitempty"`
+	Urgency             string                      `json:"urgency,omitempty"`
+	DuringSupportHours  *serviceIncidentUrgencyType `json:"during_support_hours,omitempty"`
+	OutsideSupportHours *serviceIncidentUrgencyType `json:"outside_support_hours,omitempty"`
+}
+
+type serviceIncidentUrgencyType struct {
+	Type    string `json:"type,omitempty"`
+	Urgency string `json:"urgency,omitempty"`
+}
+
+type serviceSupportHours struct {
+	Type       string `json:"type,omitempty"`
+	TimeZone   string `json:"time_zone,omitempty"`
+	StartTime  string `json:"start_time,omitempty"`
+	EndTime    string `json:"end_time,omitempty"`
+	DaysOfWeek []int  `json:"days_of_week,omitempty"`
+}
+
+type serviceScheduledAction struct {
+	Type      string                    `json:"type,omitempty"`
+	ToUrgency string                    `json:"to_urgency,omitempty"`
+	At        *serviceScheduledActionAt `json:"at,omitempty"`
+}
+
+type serviceScheduledActionAt struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// objectAs decodes a known, non null object into T.
+func objectAs[T any](ctx context.Context, obj types.Object, diags *diag.Diagnostics) (T, bool) {
+	var target T
+	if obj.IsNull() || obj.IsUnknown() {
+		return target, false
+	}
+	d := obj.As(ctx, &target, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+	diags.Append(d...)
+	return target, !d.HasError()
+}
+
+// firstListElement decodes the only element of a block set at most once,
+// reporting false when the block isn't set.
+func firstListElement[T any](ctx context.Context, l types.List, diags *diag.Diagnostics) (T, bool) {
+	var target T
+	if l.IsNull() || l.IsUnknown() || len(l.Elements()) == 0 {
+		return target, false
+	}
+	obj, ok := l.Elements()[0].(types.Object)
+	if !ok {
+		return target, false
+	}
+	return objectAs[T](ctx, obj, diags)
+}
+
+// objectList returns the value of a block set at most once, holding obj
+// unless it is null.
+func objectList(t types.ObjectType, obj types.Object) types.List {
+	if obj.IsNull() {
+		return types.ListNull(t)
+	}
+	return types.ListValueMust(t, []attr.Value{obj})
+}
+
+// buildServicePayload builds the service to send from the planned model.
+// `state` is nil when creating the service.
+func buildServicePayload(ctx context.Context, model, state *resourceServiceModel, diags *diag.Diagnostics) *servicePayload {
+	service := &servicePayload{
+		Type:          "service",
+		Name:          model.Name.ValueString(),
+		Description:   model.Description.ValueString(),
+		AlertCreation: model.AlertCreation.ValueString(),
+		EscalationPolicy: &serviceReference{
+			ID:   model.EscalationPolicy.ValueString(),
+			Type: "escalation_policy_reference",
+		},
+	}
+
+	var err error
+	if service.AutoResolveTimeout, err = parseServiceTimeout(model.AutoResolveTimeout); err != nil {
+		diags.AddAttributeError(path.Root("auto_resolve_timeout"), "Invalid value", err.Error())
+	}
+	if service.AcknowledgementTimeout, err = parseServiceTimeout(model.AcknowledgementTimeout); err != nil {
+		diags.AddAttributeError(path.Root("acknowledgement_timeout"), "Invalid value", err.Error())
+	}
+	if service.AlertGroupingTimeout, err = parseServiceTimeout(model.AlertGroupingTimeout); err != nil {
+		diags.AddAttributeError(path.Root("alert_grouping_timeout"), "Invalid value", err.Error())
+	}
+
+	if ag := model.AlertGrouping.ValueString(); ag != "" && ag != "rules" && ag != "content_based_intelligent" {
+		service.AlertGrouping = &ag
+	}
+
+	if agp, ok := firstListElement[serviceAlertGroupingParametersModel](ctx, model.AlertGroupingParameters, diags); ok {
+		service.AlertGroupingParameters = buildServiceAlertGroupingParameters(ctx, agp, diags)
+	} else if state != nil && (!model.AlertGrouping.Equal(state.AlertGrouping) ||
+		!model.AlertGroupingTimeout.Equal(state.AlertGroupingTimeout) ||
+		!model.AlertGroupingParameters.Equal(state.AlertGroupingParameters)) {
+		service.AlertGroupingParameters = &serviceAlertGroupingParameters{}
+	}
+
+	if apn, ok := firstListElement[serviceAutoPauseNotificationsParametersModel](ctx, model.AutoPauseNotificationsParameters, diags); ok {
+		service.AutoPauseNotificationsParameters = &serviceAutoPauseNotificationsParameters{Enabled: apn.Enabled.ValueBool()}
+		if apn.Enabled.ValueBool() && !apn.Timeout.IsNull() && !apn.Timeout.IsUnknown() {
+			timeout := int(apn.Timeout.ValueInt64())
+			service.AutoPauseNotificationsParameters.Timeout = &timeout
+		}
+	}
+
+	if rule, ok := firstListElement[serviceIncidentUrgencyRuleModel](ctx, model.IncidentUrgencyRule, diags); ok {
+		service.IncidentUrgencyRule = &serviceIncidentUrgencyRule{
+			Type:                rule.Type.ValueString(),
+			Urgency:             rule.Urgency.ValueString(),
+			DuringSupportHours:  buildServiceIncidentUrgencyType(ctx, rule.DuringSupportHours, diags),
+			OutsideSupportHours: buildServiceIncidentUrgencyType(ctx, rule.OutsideSupportHours, diags),
+		}
+	}
+
+	if sh, ok := firstListElement[serviceSupportHoursModel](ctx, model.SupportHours, diags); ok {
+		supportHours := &serviceSupportHours{
+			Type:      sh.Type.ValueString(),
+			TimeZone:  sh.TimeZone.ValueString(),
+			StartTime: sh.StartTime.ValueString(),
+			EndTime:   sh.EndTime.ValueString(),
+		}
+		var days []int64
+		diags.Append(sh.DaysOfWeek.ElementsAs(ctx, &days, false)...)
+		for _, day := range days {
+			supportHours.DaysOfWeek = append(supportHours.DaysOfWeek, int(day))
+		}
+		service.SupportHours = &supportHours
+	} else if state != nil && len(state.SupportHours.Elements()) > 0 {
+		var none *serviceSupportHours
+		service.SupportHours = &none
+	}
+
+	if !model.ScheduledActions.IsNull() && !model.ScheduledActions.IsUnknown() {
+		var actions []serviceScheduledActionModel
+		diags.Append(model.ScheduledActions.ElementsAs(ctx, &actions, false)...)
+		scheduledActions := []*serviceScheduledAction{}
+		for _, a := range actions {
+			action := &serviceScheduledAction{Type: a.Type.ValueString(), ToUrgency: a.ToUrgency.ValueString()}
+			if at, ok := firstListElement[serviceScheduledActionAtModel](ctx, a.At, diags); ok {
+				action.At = &serviceScheduledActionAt{Type: at.Type.ValueString(), Name: at.Name.ValueString()}
+			}
+			scheduledActions = append(scheduledActions, action)
+		}
+		service.ScheduledActions = &scheduledActions
+	} else if service.IncidentUrgencyRule != nil && service.IncidentUrgencyRule.Type == "use_support_hours" {
+		// Services using support hours are sent a single empty scheduled
+		// action when none is configured, as the API expects.
+		scheduledActions := make([]*serviceScheduledAction, 1)
+		service.ScheduledActions = &scheduledActions
+	} else if state != nil && len(state.ScheduledActions.Elements()) > 0 {
+		scheduledActions := []*serviceScheduledAction{}
+		service.ScheduledActions = &scheduledActions
+	}
+
+	if rp := model.ResponsePlay.ValueString(); rp != "" && rp != "null" {
+		service.ResponsePlay = &serviceReference{ID: rp, Type: "response_play_reference"}
+	}
+
+	return service
+}
+
+// parseServiceTimeout parses a timeout in seconds, nil standing for "null"
+// or a value not set.
+func parseServiceTimeout(v types.String) (*int, error) {
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "null" || v.ValueString() == "" {
+		return nil, nil
+	}
+	timeout, err := strconv.Atoi(v.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return &timeout, nil
+}
+
+func buildServiceAlertGroupingParameters(ctx context.Context, agp serviceAlertGroupingParametersModel, diags *diag.Diagnostics) *serviceAlertGroupingParameters {
+	params := &serviceAlertGroupingParameters{}
+	groupingType := agp.Type.ValueString()
+	if groupingType != "" {
+		params.Type = &groupingType
+	}
+
+	config, ok := firstListElement[serviceAlertGroupingConfigModel](ctx, agp.Config, diags)
+	if !ok {
+		return params
+	}
+	params.Config = &serviceAlertGroupingConfig{}
+
+	if groupingType == "time" && !config.Timeout.IsNull() && !config.Timeout.IsUnknown() {
+		timeout := int(config.Timeout.ValueInt64())
+		params.Config.Timeout = &timeout
+	}
+	if (groupingType == "intelligent" || groupingType == "content_based") && !config.TimeWindow.IsNull() && !config.TimeWindow.IsUnknown() {
+		timeWindow := int(config.TimeWindow.ValueInt64())
+		params.Config.TimeWindow = &timeWindow
+	}
+	if groupingType == "content_based" {
+		params.Config.Fields = []string{}
+		diags.Append(config.Fields.ElementsAs(ctx, &params.Config.Fields, false)...)
+		aggregate := config.Aggregate.ValueString()
+		params.Config.Aggregate = &aggregate
+	}
+	return params
+}
+
+func buildServiceIncidentUrgencyType(ctx context.Context, l types.List, diags *diag.Diagnostics) *serviceIncidentUrgencyType {
+	urgencyType, ok := firstListElement[serviceIncidentUrgencyTypeModel](ctx, l, diags)
+	if !ok {
+		return nil
+	}
+	return &serviceIncidentUrgencyType{Type: urgencyType.Type.ValueString(), Urgency: urgencyType.Urgency.ValueString()}
+}
+
+// flattenService sets the values of a service read from the API onto the
+// prior model, which is the state or the plan just applied. Values the API
+// doesn't return keep their prior value.
+func flattenService(ctx context.Context, service *servicePayload, prior resourceServiceModel, diags *diag.Diagnostics) resourceServiceModel {
+	model := prior
+	model.ID = types.StringValue(service.ID)
+	model.Name = types.StringValue(service.Name)
+	model.Type = types.StringValue(service.Type)
+	model.HTMLURL = types.StringValue(service.HTMLURL)
+	model.CreatedAt = types.StringValue(service.CreatedAt)
+	model.Description = types.StringValue(service.Description)
+	model.LastIncidentTimestamp = types.StringNull()
+	model.Status = types.StringNull()
+	if service.EscalationPolicy != nil {
+		model.EscalationPolicy = types.StringValue(service.EscalationPolicy.ID)
+	}
+
+	// All services create alerts and incidents now, the configured value is
+	// kept to avoid a diff with configurations still using create_incidents.
+	if !isKnownValue(prior.AlertCreation) {
+		model.AlertCreation = types.StringValue(service.AlertCreation)
+	}
+
+	model.AutoResolveTimeout = flattenServiceTimeout(service.AutoResolveTimeout)
+	model.AcknowledgementTimeout = flattenServiceTimeout(service.AcknowledgementTimeout)
+	model.AlertGroupingTimeout = flattenServiceTimeout(service.AlertGroupingTimeout)
+
+	if service.AlertGrouping != nil && *service.AlertGrouping != "" {
+		if *service.AlertGrouping == "rules" {
+			model.AlertGrouping = types.StringNull()
+		} else {
+			model.AlertGrouping = types.StringValue(*service.AlertGrouping)
+		}
+	} else if !isKnownValue(prior.AlertGrouping) {
+		model.AlertGrouping = types.StringNull()
+	}
+
+	// alert_grouping_parameters are only read back once configured, as they
+	// are otherwise managed by pagerduty_alert_grouping_setting.
+	if len(prior.AlertGroupingParameters.Elements()) > 0 && service.AlertGroupingParameters != nil {
+		model.AlertGroupingParameters = flattenServiceAlertGroupingParameters(ctx, prior.AlertGroupingParameters, service.AlertGroupingParameters, diags)
+	}
+
+	// Blocks can't be computed, so values the API defaults are only read
+	// back once configured, or when the service was just imported.
+	imported := prior.Name.IsNull()
+
+	if service.AutoPauseNotificationsParameters != nil && (imported || len(prior.AutoPauseNotificationsParameters.Elements()) > 0) {
+		model.AutoPauseNotificationsParameters = objectList(serviceAutoPauseNotificationsParametersObjectType, flattenServiceAutoPauseNotificationsParameters(service.AutoPauseNotificationsParameters))
+	}
+
+	if service.IncidentUrgencyRule != nil && (imported || len(prior.IncidentUrgencyRule.Elements()) > 0) {
+		model.IncidentUrgencyRule = objectList(serviceIncidentUrgencyRuleObjectType, flattenServiceIncidentUrgencyRule(service.IncidentUrgencyRule))
+	}
+
+	model.SupportHours = types.ListNull(serviceSupportHoursObjectType)
+	if service.SupportHours != nil && *service.SupportHours != nil {
+		model.SupportHours = objectList(serviceSupportHoursObjectType, flattenServiceSupportHours(*service.SupportHours))
+	}
+
+	model.ScheduledActions = types.ListNull(serviceScheduledActionObjectType)
+	if service.ScheduledActions != nil && len(*service.ScheduledActions) > 0 {
+		model.ScheduledActions = flattenServiceScheduledActions(*service.ScheduledActions)
+	}
+
+	if service.ResponsePlay != nil {
+		model.ResponsePlay = types.StringValue(service.ResponsePlay.ID)
+	} else if !isKnownValue(prior.ResponsePlay) {
+		model.ResponsePlay = types.StringNull()
+	}
+
+	return model
+}
+
+func isKnownValue(v types.String) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+func flattenServiceTimeout(v *int) types.String {
+	if v == nil {
+		return types.StringValue("null")
+	}
+	return types.StringValue(strconv.Itoa(*v))
+}
+
+func flattenServiceAlertGroupingParameters(ctx context.Context, prior types.List, v *serviceAlertGroupingParameters, diags *diag.Diagnostics) types.List {
+	groupingType := types.StringNull()
+	if v.Type != nil && *v.Type != "" {
+		groupingType = types.StringValue(*v.Type)
+	}
+
+	priorParams, _ := firstListElement[serviceAlertGroupingParametersModel](ctx, prior, diags)
+	config := types.ObjectNull(serviceAlertGroupingConfigObjectType.AttrTypes)
+	if v.Config != nil && len(priorParams.Config.Elements()) > 0 {
+		fields := types.ListNull(types.StringType)
+		if len(v.Config.Fields) > 0 {
+			var d diag.Diagnostics
+			fields, d = types.ListValueFrom(ctx, types.StringType, v.Config.Fields)
+			diags.Append(d...)
+		}
+		aggregate := types.StringNull()
+		if v.Config.Aggregate != nil && *v.Config.Aggregate != "" {
+			aggregate = types.StringValue(*v.Config.Aggregate)
+		}
+		config = types.ObjectValueMust(serviceAlertGroupingConfigObjectType.AttrTypes, map[string]attr.Value{
+			"timeout":     intPtrToInt64Value(v.Config.Timeout),
+			"fields":      fields,
+			"aggregate":   aggregate,
+			"time_window": intPtrToInt64Value(v.Config.TimeWindow),
+		})
+	}
+
+	return objectList(serviceAlertGroupingParametersObjectType, types.ObjectValueMust(serviceAlertGroupingParametersObjectType.AttrTypes, map[string]attr.Value{
+		"type":   groupingType,
+		"config": objectList(serviceAlertGroupingConfigObjectType, config),
+	}))
+}
+
+func flattenServiceAutoPauseNotificationsParameters(v *serviceAutoPauseNotificationsParameters) types.Object {
+	timeout := intPtrToInt64Value(v.Timeout)
+	if !v.Enabled && v.Timeout == nil {
+		timeout = types.Int64Value(120)
+	}
+	return types.ObjectValueMust(serviceAutoPauseNotificationsParametersObjectType.AttrTypes, map[string]attr.Value{
+		"enabled": types.BoolValue(v.Enabled),
+		"timeout": timeout,
+	})
+}
+
+func flattenServiceIncidentUrgencyRule(v *serviceIncidentUrgencyRule) types.Object {
+	return types.ObjectValueMust(serviceIncidentUrgencyRuleObjectType.AttrTypes, map[string]attr.Value{
+		"type":                  types.StringValue(v.Type),
+		"urgency":               stringToStringValue(v.Urgency),
+		"during_support_hours":  objectList(serviceIncidentUrgencyTypeObjectType, flattenServiceIncidentUrgencyType(v.DuringSupportHours)),
+		"outside_support_hours": objectList(serviceIncidentUrgencyTypeObjectType, flattenServiceIncidentUrgencyType(v.OutsideSupportHours)),
+	})
+}
+
+func flattenServiceIncidentUrgencyType(v *serviceIncidentUrgencyType) types.Object {
+	if v == nil {
+		return types.ObjectNull(serviceIncidentUrgencyTypeObjectType.AttrTypes)
+	}
+	return types.ObjectValueMust(serviceIncidentUrgencyTypeObjectType.AttrTypes, map[string]attr.Value{
+		"type":    stringToStringValue(v.Type),
+		"urgency": stringToStringValue(v.Urgency),
+	})
+}
+
+func flattenServiceSupportHours(v *serviceSupportHours) types.Object {
+	days := types.ListNull(types.Int64Type)
+	if len(v.DaysOfWeek) > 0 {
+		elements := make([]attr.Value, 0, len(v.DaysOfWeek))
+		for _, day := range v.DaysOfWeek {
+			elements = append(elements, types.Int64Value(int64(day)))
+		}
+		days = types.ListValueMust(types.Int64Type, elements)
+	}
+	return types.ObjectValueMust(serviceSupportHoursObjectType.AttrTypes, map[string]attr.Value{
+		"type":         stringToStringValue(v.Type),
+		"time_zone":    stringToStringValue(v.TimeZone),
+		"start_time":   stringToStringValue(v.StartTime),
+		"end_time":     stringToStringValue(v.EndTime),
+		"days_of_week": days,
+	})
+}
+
+func flattenServiceScheduledActions(v []*serviceScheduledAction) types.List {
+	elements := make([]attr.Value, 0, len(v))
+	for _, sa := range v {
+		if sa == nil {
+			continue
+		}
+		at := types.ObjectNull(serviceScheduledActionAtObjectType.AttrTypes)
+		if sa.At != nil {
+			at = types.ObjectValueMust(serviceScheduledActionAtObjectType.AttrTypes, map[string]attr.Value{
+				"type": stringToStringValue(sa.At.Type),
+				"name": stringToStringValue(sa.At.Name),
+			})
+		}
+		elements = append(elements, types.ObjectValueMust(serviceScheduledActionObjectType.AttrTypes, map[string]attr.Value{
+			"type":       stringToStringValue(sa.Type),
+			"to_urgency": stringToStringValue(sa.ToUrgency),
+			"at":         objectList(serviceScheduledActionAtObjectType, at),
+		}))
+	}
+	return types.ListValueMust(serviceScheduledActionObjectType, elements)
+}
+
+// stringToStringValue maps the empty strings the API uses for values not set
+// to null.
+func stringToStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func intPtrToInt64Value(v *int) types.Int64 {
+	if v == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*v))
+}
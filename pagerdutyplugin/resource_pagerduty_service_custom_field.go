@@ -131,20 +131,7 @@ func (r *ServiceCustomFieldResource) Schema(_ context.Context, _ resource.Schema
 }
 
 func (r *ServiceCustomFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*pagerduty.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *pagerduty.Client, got: %T", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
 func (r *ServiceCustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
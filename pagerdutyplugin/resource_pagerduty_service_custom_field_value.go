@@ -84,20 +84,7 @@ func (r *ServiceCustomFieldValueResource) Schema(_ context.Context, _ resource.S
 }
 
 func (r *ServiceCustomFieldValueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*pagerduty.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *pagerduty.Client, got: %T", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
 }
 
 func (r *ServiceCustomFieldValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
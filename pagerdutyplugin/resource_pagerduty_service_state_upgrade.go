@@ -0,0 +1,299 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState upgrades the state written by the SDKv2 implementation of
+// pagerduty_service, which kept empty strings and the default urgency rule
+// for the values not configured.
+func (r *resourceService) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   resourceServiceSchemaV0(),
+			StateUpgrader: upgradeServiceStateV0,
+		},
+	}
+}
+
+func resourceServiceSchemaV0() *schema.Schema {
+	urgencyType := schema.ListNestedAttribute{
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+			"type":    schema.StringAttribute{Optional: true},
+			"urgency": schema.StringAttribute{Optional: true},
+		}},
+	}
+
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                      schema.StringAttribute{Computed: true},
+			"name":                    schema.StringAttribute{Required: true},
+			"html_url":                schema.StringAttribute{Computed: true},
+			"description":             schema.StringAttribute{Optional: true},
+			"alert_creation":          schema.StringAttribute{Optional: true},
+			"alert_grouping":          schema.StringAttribute{Optional: true},
+			"alert_grouping_timeout":  schema.StringAttribute{Optional: true},
+			"auto_resolve_timeout":    schema.StringAttribute{Optional: true},
+			"acknowledgement_timeout": schema.StringAttribute{Optional: true},
+			"last_incident_timestamp": schema.StringAttribute{Computed: true},
+			"created_at":              schema.StringAttribute{Computed: true},
+			"status":                  schema.StringAttribute{Computed: true},
+			"escalation_policy":       schema.StringAttribute{Required: true},
+			"type":                    schema.StringAttribute{Computed: true},
+			"response_play":           schema.StringAttribute{Optional: true},
+			"deletion_protection":     schema.BoolAttribute{Optional: true},
+			"alert_grouping_parameters": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{Optional: true},
+					"config": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+							"timeout":     schema.Int64Attribute{Optional: true},
+							"fields":      schema.ListAttribute{Optional: true, ElementType: types.StringType},
+							"aggregate":   schema.StringAttribute{Optional: true},
+							"time_window": schema.Int64Attribute{Optional: true},
+						}},
+					},
+				}},
+			},
+			"auto_pause_notifications_parameters": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{Optional: true},
+					"timeout": schema.Int64Attribute{Optional: true},
+				}},
+			},
+			"incident_urgency_rule": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"type":                  schema.StringAttribute{Optional: true},
+					"urgency":               schema.StringAttribute{Optional: true},
+					"during_support_hours":  urgencyType,
+					"outside_support_hours": urgencyType,
+				}},
+			},
+			"support_hours": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"type":         schema.StringAttribute{Optional: true},
+					"time_zone":    schema.StringAttribute{Optional: true},
+					"start_time":   schema.StringAttribute{Optional: true},
+					"end_time":     schema.StringAttribute{Optional: true},
+					"days_of_week": schema.ListAttribute{Optional: true, ElementType: types.Int64Type},
+				}},
+			},
+			"scheduled_actions": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"type":       schema.StringAttribute{Optional: true},
+					"to_urgency": schema.StringAttribute{Optional: true},
+					"at": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{Optional: true},
+							"name": schema.StringAttribute{Optional: true},
+						}},
+					},
+				}},
+			},
+		},
+	}
+}
+
+type resourceServiceModelV0 struct {
+	ID                               types.String `tfsdk:"id"`
+	Name                             types.String `tfsdk:"name"`
+	HTMLURL                          types.String `tfsdk:"html_url"`
+	Description                      types.String `tfsdk:"description"`
+	AlertCreation                    types.String `tfsdk:"alert_creation"`
+	AlertGrouping                    types.String `tfsdk:"alert_grouping"`
+	AlertGroupingTimeout             types.String `tfsdk:"alert_grouping_timeout"`
+	AlertGroupingParameters          types.List   `tfsdk:"alert_grouping_parameters"`
+	AutoPauseNotificationsParameters types.List   `tfsdk:"auto_pause_notifications_parameters"`
+	AutoResolveTimeout               types.String `tfsdk:"auto_resolve_timeout"`
+	AcknowledgementTimeout           types.String `tfsdk:"acknowledgement_timeout"`
+	LastIncidentTimestamp            types.String `tfsdk:"last_incident_timestamp"`
+	CreatedAt                        types.String `tfsdk:"created_at"`
+	Status                           types.String `tfsdk:"status"`
+	EscalationPolicy                 types.String `tfsdk:"escalation_policy"`
+	IncidentUrgencyRule              types.List   `tfsdk:"incident_urgency_rule"`
+	SupportHours                     types.List   `tfsdk:"support_hours"`
+	ScheduledActions                 types.List   `tfsdk:"scheduled_actions"`
+	Type                             types.String `tfsdk:"type"`
+	ResponsePlay                     types.String `tfsdk:"response_play"`
+	DeletionProtection               types.Bool   `tfsdk:"deletion_protection"`
+}
+
+type serviceAlertGroupingParametersModelV0 struct {
+	Type   types.String `tfsdk:"type"`
+	Config types.List   `tfsdk:"config"`
+}
+
+type serviceIncidentUrgencyRuleModelV0 struct {
+	Type                types.String `tfsdk:"type"`
+	Urgency             types.String `tfsdk:"urgency"`
+	DuringSupportHours  types.List   `tfsdk:"during_support_hours"`
+	OutsideSupportHours types.List   `tfsdk:"outside_support_hours"`
+}
+
+type serviceScheduledActionModelV0 struct {
+	Type      types.String `tfsdk:"type"`
+	ToUrgency types.String `tfsdk:"to_urgency"`
+	At        types.List   `tfsdk:"at"`
+}
+
+func upgradeServiceStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior resourceServiceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := resourceServiceModel{
+		ID:                               prior.ID,
+		Name:                             prior.Name,
+		HTMLURL:                          prior.HTMLURL,
+		Description:                      prior.Description,
+		AlertCreation:                    prior.AlertCreation,
+		AlertGrouping:                    emptyStringToNull(prior.AlertGrouping),
+		AlertGroupingTimeout:             emptyStringToNull(prior.AlertGroupingTimeout),
+		AlertGroupingParameters:          types.ListNull(serviceAlertGroupingParametersObjectType),
+		AutoPauseNotificationsParameters: types.ListNull(serviceAutoPauseNotificationsParametersObjectType),
+		AutoResolveTimeout:               prior.AutoResolveTimeout,
+		AcknowledgementTimeout:           prior.AcknowledgementTimeout,
+		LastIncidentTimestamp:            emptyStringToNull(prior.LastIncidentTimestamp),
+		CreatedAt:                        prior.CreatedAt,
+		Status:                           emptyStringToNull(prior.Status),
+		EscalationPolicy:                 prior.EscalationPolicy,
+		IncidentUrgencyRule:              types.ListNull(serviceIncidentUrgencyRuleObjectType),
+		SupportHours:                     types.ListNull(serviceSupportHoursObjectType),
+		ScheduledActions:                 types.ListNull(serviceScheduledActionObjectType),
+		Type:                             prior.Type,
+		ResponsePlay:                     emptyStringToNull(prior.ResponsePlay),
+		DeletionProtection:               prior.DeletionProtection,
+	}
+	if model.DeletionProtection.IsNull() {
+		model.DeletionProtection = types.BoolValue(false)
+	}
+
+	diags := &resp.Diagnostics
+
+	if agp, ok := firstListElement[serviceAlertGroupingParametersModelV0](ctx, prior.AlertGroupingParameters, diags); ok {
+		config := types.ObjectNull(serviceAlertGroupingConfigObjectType.AttrTypes)
+		if c, ok := firstListElement[serviceAlertGroupingConfigModel](ctx, agp.Config, diags); ok {
+			config = types.ObjectValueMust(serviceAlertGroupingConfigObjectType.AttrTypes, map[string]attr.Value{
+				"timeout":     zeroInt64ToNull(c.Timeout),
+				"fields":      emptyListToNull(c.Fields),
+				"aggregate":   emptyStringToNull(c.Aggregate),
+				"time_window": zeroInt64ToNull(c.TimeWindow),
+			})
+		}
+		model.AlertGroupingParameters = objectList(serviceAlertGroupingParametersObjectType, types.ObjectValueMust(serviceAlertGroupingParametersObjectType.AttrTypes, map[string]attr.Value{
+			"type":   emptyStringToNull(agp.Type),
+			"config": objectList(serviceAlertGroupingConfigObjectType, config),
+		}))
+	}
+
+	if apn, ok := firstListElement[serviceAutoPauseNotificationsParametersModel](ctx, prior.AutoPauseNotificationsParameters, diags); ok {
+		model.AutoPauseNotificationsParameters = objectList(serviceAutoPauseNotificationsParametersObjectType, types.ObjectValueMust(serviceAutoPauseNotificationsParametersObjectType.AttrTypes, map[string]attr.Value{
+			"enabled": apn.Enabled,
+			"timeout": zeroInt64ToNull(apn.Timeout),
+		}))
+	}
+
+	// The SDKv2 implementation kept the urgency rule the API defaults to in
+	// the state even when it wasn't configured, while a block is only tracked
+	// when configured now. The default rule is dropped so that upgrading
+	// doesn't plan its removal.
+	if rule, ok := firstListElement[serviceIncidentUrgencyRuleModelV0](ctx, prior.IncidentUrgencyRule, diags); ok && !isDefaultServiceIncidentUrgencyRuleV0(rule) {
+		model.IncidentUrgencyRule = objectList(serviceIncidentUrgencyRuleObjectType, types.ObjectValueMust(serviceIncidentUrgencyRuleObjectType.AttrTypes, map[string]attr.Value{
+			"type":                  rule.Type,
+			"urgency":               emptyStringToNull(rule.Urgency),
+			"during_support_hours":  upgradeServiceIncidentUrgencyTypeV0(ctx, rule.DuringSupportHours, diags),
+			"outside_support_hours": upgradeServiceIncidentUrgencyTypeV0(ctx, rule.OutsideSupportHours, diags),
+		}))
+	}
+
+	if sh, ok := firstListElement[serviceSupportHoursModel](ctx, prior.SupportHours, diags); ok {
+		model.SupportHours = objectList(serviceSupportHoursObjectType, types.ObjectValueMust(serviceSupportHoursObjectType.AttrTypes, map[string]attr.Value{
+			"type":         emptyStringToNull(sh.Type),
+			"time_zone":    emptyStringToNull(sh.TimeZone),
+			"start_time":   emptyStringToNull(sh.StartTime),
+			"end_time":     emptyStringToNull(sh.EndTime),
+			"days_of_week": emptyListToNull(sh.DaysOfWeek),
+		}))
+	}
+
+	if len(prior.ScheduledActions.Elements()) > 0 {
+		var actions []serviceScheduledActionModelV0
+		diags.Append(prior.ScheduledActions.ElementsAs(ctx, &actions, false)...)
+		elements := make([]attr.Value, 0, len(actions))
+		for _, a := range actions {
+			at := types.ObjectNull(serviceScheduledActionAtObjectType.AttrTypes)
+			if v, ok := firstListElement[serviceScheduledActionAtModel](ctx, a.At, diags); ok {
+				at = types.ObjectValueMust(serviceScheduledActionAtObjectType.AttrTypes, map[string]attr.Value{
+					"type": emptyStringToNull(v.Type),
+					"name": emptyStringToNull(v.Name),
+				})
+			}
+			elements = append(elements, types.ObjectValueMust(serviceScheduledActionObjectType.AttrTypes, map[string]attr.Value{
+				"type":       emptyStringToNull(a.Type),
+				"to_urgency": emptyStringToNull(a.ToUrgency),
+				"at":         objectList(serviceScheduledActionAtObjectType, at),
+			}))
+		}
+		model.ScheduledActions = types.ListValueMust(serviceScheduledActionObjectType, elements)
+	}
+
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// isDefaultServiceIncidentUrgencyRuleV0 tells whether an urgency rule is the
+// one PagerDuty assigns to services created without any.
+func isDefaultServiceIncidentUrgencyRuleV0(rule serviceIncidentUrgencyRuleModelV0) bool {
+	return rule.Type.ValueString() == "constant" && rule.Urgency.ValueString() == "high" &&
+		len(rule.DuringSupportHours.Elements()) == 0 && len(rule.OutsideSupportHours.Elements()) == 0
+}
+
+func upgradeServiceIncidentUrgencyTypeV0(ctx context.Context, l types.List, diags *diag.Diagnostics) types.List {
+	v, ok := firstListElement[serviceIncidentUrgencyTypeModel](ctx, l, diags)
+	if !ok {
+		return types.ListNull(serviceIncidentUrgencyTypeObjectType)
+	}
+	return objectList(serviceIncidentUrgencyTypeObjectType, types.ObjectValueMust(serviceIncidentUrgencyTypeObjectType.AttrTypes, map[string]attr.Value{
+		"type":    emptyStringToNull(v.Type),
+		"urgency": emptyStringToNull(v.Urgency),
+	}))
+}
+
+func emptyStringToNull(v types.String) types.String {
+	if v.ValueString() == "" {
+		return types.StringNull()
+	}
+	return v
+}
+
+func zeroInt64ToNull(v types.Int64) types.Int64 {
+	if v.ValueInt64() == 0 {
+		return types.Int64Null()
+	}
+	return v
+}
+
+func emptyListToNull(v types.List) types.List {
+	if len(v.Elements()) == 0 {
+		return types.ListNull(v.ElementType(context.Background()))
+	}
+	return v
+}
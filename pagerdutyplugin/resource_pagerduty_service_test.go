@@ -1,16 +1,26 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"math/big"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -21,31 +31,338 @@ func init() {
 }
 
 func testSweepService(region string) error {
-	config, err := sharedConfigForRegion(region)
+	ctx := context.Background()
+	services, err := testAccProvider.client.ListServicesPaginated(ctx, pagerduty.ListServiceOptions{})
 	if err != nil {
 		return err
 	}
 
-	client, err := config.Client()
+	for _, service := range services {
+		if strings.HasPrefix(service.Name, "test") || strings.HasPrefix(service.Name, "tf-") {
+			log.Printf("Destroying service %s (%s)", service.Name, service.ID)
+			if err := testAccProvider.client.DeleteServiceWithContext(ctx, service.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestResourcePagerDutyServiceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol5WithError(New())()
 	if err != nil {
-		return err
+		t.Fatal(err)
 	}
 
-	resp, _, err := client.Services.List(&pagerduty.ListServicesOptions{})
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
 	if err != nil {
-		return err
+		t.Fatal(err)
+	}
+	for _, d := range schemaResp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
 	}
 
-	for _, service := range resp.Services {
-		if strings.HasPrefix(service.Name, "test") || strings.HasPrefix(service.Name, "tf-") {
-			log.Printf("Destroying service %s (%s)", service.Name, service.ID)
-			if _, err := client.Services.Delete(service.ID); err != nil {
-				return err
+	// State as written by the SDKv2 implementation of the resource.
+	rawState := []byte(`{
+		"id": "PSERVIC",
+		"name": "foo",
+		"html_url": "https://example.pagerduty.com/service-directory/PSERVIC",
+		"description": "Managed by Terraform",
+		"alert_creation": "create_alerts_and_incidents",
+		"alert_grouping": "",
+		"alert_grouping_timeout": "null",
+		"alert_grouping_parameters": [{"type": "content_based", "config": [{"timeout": 0, "fields": ["fields.alert_key"], "aggregate": "all", "time_window": 300}]}],
+		"auto_pause_notifications_parameters": [{"enabled": true, "timeout": 300}],
+		"auto_resolve_timeout": "null",
+		"acknowledgement_timeout": "1800",
+		"last_incident_timestamp": "",
+		"created_at": "2024-01-01T00:00:00Z",
+		"status": "",
+		"escalation_policy": "PESCALA",
+		"incident_urgency_rule": [{
+			"type": "use_support_hours",
+			"urgency": "",
+			"during_support_hours": [{"type": "constant", "urgency": "high"}],
+			"outside_support_hours": [{"type": "constant", "urgency": "low"}]
+		}],
+		"support_hours": [{"type": "fixed_time_per_day", "time_zone": "America/Lima", "start_time": "09:00:00", "end_time": "17:00:00", "days_of_week": [1, 2, 3, 4, 5]}],
+		"scheduled_actions": [{"type": "urgency_change", "to_urgency": "high", "at": [{"type": "named_time", "name": "support_hours_start"}]}],
+		"type": "service",
+		"response_play": ""
+	}`)
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: "pagerduty_service",
+		Version:  0,
+		RawState: &tfprotov5.RawState{JSON: rawState},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("%s: %s", d.Summary, d.Detail)
+	}
+
+	state, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas["pagerduty_service"].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[*tftypes.AttributePath]interface{}{
+		tftypes.NewAttributePath().WithAttributeName("name"):                                                                     "foo",
+		tftypes.NewAttributePath().WithAttributeName("auto_resolve_timeout"):                                                     "null",
+		tftypes.NewAttributePath().WithAttributeName("alert_grouping"):                                                           nil,
+		tftypes.NewAttributePath().WithAttributeName("status"):                                                                   nil,
+		tftypes.NewAttributePath().WithAttributeName("response_play"):                                                            nil,
+		tftypes.NewAttributePath().WithAttributeName("deletion_protection"):                                                      false,
+		tftypes.NewAttributePath().WithAttributeName("alert_grouping_parameters").WithElementKeyInt(0).WithAttributeName("type"): "content_based",
+		tftypes.NewAttributePath().WithAttributeName("alert_grouping_parameters").WithElementKeyInt(0).WithAttributeName("config").WithElementKeyInt(0).WithAttributeName("timeout"):            nil,
+		tftypes.NewAttributePath().WithAttributeName("alert_grouping_parameters").WithElementKeyInt(0).WithAttributeName("config").WithElementKeyInt(0).WithAttributeName("aggregate"):          "all",
+		tftypes.NewAttributePath().WithAttributeName("auto_pause_notifications_parameters").WithElementKeyInt(0).WithAttributeName("timeout"):                                                   int64(300),
+		tftypes.NewAttributePath().WithAttributeName("incident_urgency_rule").WithElementKeyInt(0).WithAttributeName("urgency"):                                                                 nil,
+		tftypes.NewAttributePath().WithAttributeName("incident_urgency_rule").WithElementKeyInt(0).WithAttributeName("outside_support_hours").WithElementKeyInt(0).WithAttributeName("urgency"): "low",
+		tftypes.NewAttributePath().WithAttributeName("support_hours").WithElementKeyInt(0).WithAttributeName("time_zone"):                                                                       "America/Lima",
+		tftypes.NewAttributePath().WithAttributeName("scheduled_actions").WithElementKeyInt(0).WithAttributeName("at").WithElementKeyInt(0).WithAttributeName("name"):                           "support_hours_start",
+	}
+	for p, want := range expected {
+		v, _, err := tftypes.WalkAttributePath(state, p)
+		if err != nil {
+			t.Fatalf("%s: %s", p, err)
+		}
+		value := v.(tftypes.Value)
+
+		var got interface{}
+		switch want.(type) {
+		case string:
+			var s string
+			err = value.As(&s)
+			got = s
+		case bool:
+			var b bool
+			err = value.As(&b)
+			got = b
+		case int64:
+			var n big.Float
+			err = value.As(&n)
+			got, _ = n.Int64()
+		case nil:
+			if !value.IsNull() {
+				got = value.String()
 			}
 		}
+		if err != nil {
+			t.Fatalf("%s: %s", p, err)
+		}
+		if got != want {
+			t.Errorf("%s: expected %v, got %v", p, want, got)
+		}
 	}
+}
 
-	return nil
+func TestResourcePagerDutyServiceUpgradeStateV0_IncidentUrgencyRule(t *testing.T) {
+	rule := tftypes.NewAttributePath().WithAttributeName("incident_urgency_rule")
+
+	cases := []struct {
+		name string
+		// incident_urgency_rule as written by the SDKv2 implementation
+		rawRule  string
+		expected map[*tftypes.AttributePath]interface{}
+	}{
+		{
+			name:     "without the block",
+			rawRule:  `[]`,
+			expected: map[*tftypes.AttributePath]interface{}{rule: 0},
+		},
+		{
+			name:     "default rule",
+			rawRule:  `[{"type": "constant", "urgency": "high", "during_support_hours": [], "outside_support_hours": []}]`,
+			expected: map[*tftypes.AttributePath]interface{}{rule: 0},
+		},
+		{
+			name:    "configured rule",
+			rawRule: `[{"type": "constant", "urgency": "low", "during_support_hours": [], "outside_support_hours": []}]`,
+			expected: map[*tftypes.AttributePath]interface{}{
+				rule.WithElementKeyInt(0).WithAttributeName("type"):                  "constant",
+				rule.WithElementKeyInt(0).WithAttributeName("urgency"):               "low",
+				rule.WithElementKeyInt(0).WithAttributeName("during_support_hours"):  0,
+				rule.WithElementKeyInt(0).WithAttributeName("outside_support_hours"): 0,
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rawState := []byte(fmt.Sprintf(`{
+				"id": "PSERVIC",
+				"name": "foo",
+				"html_url": "https://example.pagerduty.com/service-directory/PSERVIC",
+				"description": "Managed by Terraform",
+				"alert_creation": "create_alerts_and_incidents",
+				"alert_grouping_parameters": [],
+				"auto_pause_notifications_parameters": [],
+				"auto_resolve_timeout": "14400",
+				"acknowledgement_timeout": "600",
+				"created_at": "2024-01-01T00:00:00Z",
+				"status": "active",
+				"escalation_policy": "PESCALA",
+				"incident_urgency_rule": %s,
+				"support_hours": [],
+				"scheduled_actions": [],
+				"type": "service"
+			}`, c.rawRule))
+
+			state := testUpgradeResourceStateV0(t, "pagerduty_service", rawState)
+			testCheckUpgradedState(t, state, c.expected)
+		})
+	}
+}
+
+func TestValidateServiceUrgencyConfig(t *testing.T) {
+	urgencyType := func(urgency string) types.List {
+		return objectList(serviceIncidentUrgencyTypeObjectType, types.ObjectValueMust(serviceIncidentUrgencyTypeObjectType.AttrTypes, map[string]attr.Value{
+			"type":    types.StringValue("constant"),
+			"urgency": types.StringValue(urgency),
+		}))
+	}
+	rule := func(ruleType string, urgency types.String, during, outside types.List) types.List {
+		return objectList(serviceIncidentUrgencyRuleObjectType, types.ObjectValueMust(serviceIncidentUrgencyRuleObjectType.AttrTypes, map[string]attr.Value{
+			"type":                  types.StringValue(ruleType),
+			"urgency":               urgency,
+			"during_support_hours":  during,
+			"outside_support_hours": outside,
+		}))
+	}
+	supportHours := func(start, end string, days ...int64) types.List {
+		elements := []attr.Value{}
+		for _, d := range days {
+			elements = append(elements, types.Int64Value(d))
+		}
+		return objectList(serviceSupportHoursObjectType, types.ObjectValueMust(serviceSupportHoursObjectType.AttrTypes, map[string]attr.Value{
+			"type":         types.StringValue("fixed_time_per_day"),
+			"time_zone":    types.StringValue("America/Lima"),
+			"start_time":   types.StringValue(start),
+			"end_time":     types.StringValue(end),
+			"days_of_week": types.ListValueMust(types.Int64Type, elements),
+		}))
+	}
+	scheduledActions := func(name string) types.List {
+		return types.ListValueMust(serviceScheduledActionObjectType, []attr.Value{
+			types.ObjectValueMust(serviceScheduledActionObjectType.AttrTypes, map[string]attr.Value{
+				"type":       types.StringValue("urgency_change"),
+				"to_urgency": types.StringValue("high"),
+				"at": objectList(serviceScheduledActionAtObjectType, types.ObjectValueMust(serviceScheduledActionAtObjectType.AttrTypes, map[string]attr.Value{
+					"type": types.StringValue("named_time"),
+					"name": types.StringValue(name),
+				})),
+			}),
+		})
+	}
+	nullUrgencyType := types.ListNull(serviceIncidentUrgencyTypeObjectType)
+	useSupportHours := rule("use_support_hours", types.StringNull(), urgencyType("high"), urgencyType("low"))
+
+	cases := map[string]struct {
+		model    resourceServiceModel
+		wantPath path.Path
+		wantErr  string
+	}{
+		"valid support hours": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: useSupportHours,
+				SupportHours:        supportHours("09:00:00", "17:00:00", 1, 2, 3, 4, 5),
+				ScheduledActions:    scheduledActions("support_hours_start"),
+			},
+		},
+		"valid constant": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: rule("constant", types.StringValue("high"), nullUrgencyType, nullUrgencyType),
+			},
+		},
+		"constant with nested urgencies": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: rule("constant", types.StringValue("high"), urgencyType("high"), nullUrgencyType),
+			},
+			wantPath: path.Root("incident_urgency_rule").AtListIndex(0),
+			wantErr:  "cannot be set for a constant incident urgency rule type",
+		},
+		"constant without urgency": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: rule("constant", types.StringNull(), nullUrgencyType, nullUrgencyType),
+			},
+			wantPath: path.Root("incident_urgency_rule").AtListIndex(0).AtName("urgency"),
+			wantErr:  "urgency is required",
+		},
+		"use_support_hours without outside urgency": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: rule("use_support_hours", types.StringNull(), urgencyType("high"), nullUrgencyType),
+				SupportHours:        supportHours("09:00:00", "17:00:00", 1),
+			},
+			wantPath: path.Root("incident_urgency_rule").AtListIndex(0).AtName("outside_support_hours"),
+			wantErr:  "outside_support_hours is required",
+		},
+		"start after end": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: useSupportHours,
+				SupportHours:        supportHours("17:00:00", "09:00:00", 1),
+			},
+			wantPath: path.Root("support_hours").AtListIndex(0).AtName("end_time"),
+			wantErr:  "must be after start_time",
+		},
+		"invalid time": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: useSupportHours,
+				SupportHours:        supportHours("9:00", "17:00:00", 1),
+			},
+			wantPath: path.Root("support_hours").AtListIndex(0).AtName("start_time"),
+			wantErr:  "must be of 00:00:00 format",
+		},
+		"overlapping days": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: useSupportHours,
+				SupportHours:        supportHours("09:00:00", "17:00:00", 1, 2, 2),
+			},
+			wantPath: path.Root("support_hours").AtListIndex(0).AtName("days_of_week").AtListIndex(2),
+			wantErr:  "day 2 is set more than once",
+		},
+		"scheduled actions without support hours": {
+			model: resourceServiceModel{
+				ScheduledActions: scheduledActions("support_hours_start"),
+			},
+			wantPath: path.Root("scheduled_actions"),
+			wantErr:  "can only be set along with support_hours",
+		},
+		"scheduled action at an unknown time": {
+			model: resourceServiceModel{
+				IncidentUrgencyRule: useSupportHours,
+				SupportHours:        supportHours("09:00:00", "17:00:00", 1),
+				ScheduledActions:    scheduledActions("noon"),
+			},
+			wantPath: path.Root("scheduled_actions").AtListIndex(0).AtName("at").AtListIndex(0).AtName("name"),
+			wantErr:  "must be support_hours_start or support_hours_end",
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateServiceUrgencyConfig(context.Background(), &c.model, &diags)
+			if c.wantErr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got: %v", diags)
+			}
+			d, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+			if !ok || !d.Path().Equal(c.wantPath) {
+				t.Errorf("expected an error at %s, got: %v", c.wantPath, diags.Errors()[0])
+			}
+			if !strings.Contains(diags.Errors()[0].Detail(), c.wantErr) {
+				t.Errorf("expected %q, got %q", c.wantErr, diags.Errors()[0].Detail())
+			}
+		})
+	}
 }
 
 func TestAccPagerDutyService_Basic(t *testing.T) {
@@ -56,9 +373,9 @@ func TestAccPagerDutyService_Basic(t *testing.T) {
 	serviceUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service),
@@ -78,12 +395,8 @@ func TestAccPagerDutyService_Basic(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_grouping_timeout", "null"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 					resource.TestCheckResourceAttrSet(
 						"pagerduty_service.foo", "html_url"),
 					resource.TestCheckResourceAttr(
@@ -142,9 +455,9 @@ func TestAccPagerDutyService_FormatValidation(t *testing.T) {
 	errMessageMatcher := "Name can not be blank, nor contain non-printable characters. Trailing white spaces are not allowed either."
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			// Just a valid name
 			{
@@ -310,7 +623,7 @@ func TestAccPagerDutyService_FormatValidation(t *testing.T) {
           `,
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Alert grouping time window value must be between 300 and 3600"),
+				ExpectError: regexp.MustCompile("value must be between 300 and 3600"),
 			},
 			{
 				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
@@ -337,7 +650,7 @@ func TestAccPagerDutyService_FormatValidation(t *testing.T) {
           `,
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Alert grouping time window value must be between 300 and 3600"),
+				ExpectError: regexp.MustCompile("value must be between 300 and 3600"),
 			},
 			{
 				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
@@ -370,7 +683,7 @@ func TestAccPagerDutyService_FormatValidation(t *testing.T) {
           `,
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("when using type = use_support_hours in incident_urgency_rule you must specify exactly one .* support_hours block"),
+				ExpectError: regexp.MustCompile("when using type = use_support_hours in incident_urgency_rule you must specify support_hours"),
 			},
 			{
 				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
@@ -417,9 +730,9 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{ // 1
 				Config: testAccCheckPagerDutyServiceConfigWithAlertContentGrouping(username, email, escalationPolicy, service),
@@ -435,20 +748,16 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 						"pagerduty_service.foo", "acknowledgement_timeout", "1800"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_creation", "create_alerts_and_incidents"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "alert_grouping", ""),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "alert_grouping"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.aggregate", "all"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_grouping_parameters.0.type", "content_based"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.fields.0", "custom_details.field1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{ // 2
@@ -473,12 +782,8 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping_parameters.0.type", "intelligent"),
 					// resource.TestCheckNoResourceAttr(
 					// 	"pagerduty_service.foo", "alert_grouping_parameters.0.config.0"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{ // 4
@@ -499,12 +804,8 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 					// 	"pagerduty_service.foo", "alert_grouping_parameters.0.config"),
 					// resource.TestCheckNoResourceAttr(
 					// 	"pagerduty_service.foo", "alert_grouping_parameters.0.type"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{ // 5
@@ -527,12 +828,8 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.timeout", "5"),
 					// resource.TestCheckNoResourceAttr(
 					// 	"pagerduty_service.foo", "alert_grouping_parameters.0.config.0"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{ // 6
@@ -555,12 +852,8 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.timeout", "0"),
 					// resource.TestCheckNoResourceAttr(
 					// 	"pagerduty_service.foo", "alert_grouping_parameters.0.config.0"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{ // 7
@@ -581,12 +874,8 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping_parameters.0.type", "intelligent"),
 					// resource.TestCheckNoResourceAttr(
 					// 	"pagerduty_service.foo", "alert_grouping_parameters.0.config.0"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{ // 8
@@ -607,12 +896,8 @@ func TestAccPagerDutyService_AlertGroupingContentBased(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping_parameters.0.type", "intelligent"),
 					// resource.TestCheckNoResourceAttr(
 					// 	"pagerduty_service.foo", "alert_grouping_parameters.0.config.0"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{ // 9
@@ -650,9 +935,9 @@ func TestAccPagerDutyService_AlertContentGroupingIntelligentTimeWindow(t *testin
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfigWithAlertContentGroupingIntelligentTimeWindow(username, email, escalationPolicy, service),
@@ -694,9 +979,9 @@ func TestAccPagerDutyService_Delete24HAlertGrouping(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfigWithAlertContentGrouping24H(group, email, group, service),
@@ -727,9 +1012,9 @@ func TestAccPagerDutyService_AutoPauseNotificationsParameters(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfigWithAutoPauseNotificationsParameters(username, email, escalationPolicy, service),
@@ -789,12 +1074,8 @@ func TestAccPagerDutyService_AutoPauseNotificationsParameters(t *testing.T) {
 						"pagerduty_service.foo", "acknowledgement_timeout", "1800"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_creation", "create_alerts_and_incidents"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "auto_pause_notifications_parameters.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "auto_pause_notifications_parameters.0.enabled", "false"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "auto_pause_notifications_parameters.0.timeout", "120"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "auto_pause_notifications_parameters.0.enabled"),
 				),
 			},
 		},
@@ -809,9 +1090,9 @@ func TestAccPagerDutyService_BasicWithIncidentUrgencyRules(t *testing.T) {
 	serviceUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRulesConfig(username, email, escalationPolicy, service),
@@ -1011,9 +1292,9 @@ func TestAccPagerDutyService_FromBasicToCustomIncidentUrgencyRules(t *testing.T)
 	serviceUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service),
@@ -1029,12 +1310,8 @@ func TestAccPagerDutyService_FromBasicToCustomIncidentUrgencyRules(t *testing.T)
 						"pagerduty_service.foo", "acknowledgement_timeout", "1800"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_creation", "create_alerts_and_incidents"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 				),
 			},
 			{
@@ -1116,9 +1393,9 @@ func TestAccPagerDutyService_SupportHoursChange(t *testing.T) {
 	p_updated_service_id := &updated_service_id
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceWithIncidentUrgencyRulesConfig(username, email, escalationPolicy, service),
@@ -1154,9 +1431,9 @@ func TestAccPagerDutyService_ResponsePlay(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceWithResponsePlayConfig(username, email, escalationPolicy, responsePlay, service),
@@ -1176,12 +1453,8 @@ func TestAccPagerDutyService_ResponsePlay(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_grouping_timeout", "null"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 					resource.TestCheckResourceAttrSet(
 						"pagerduty_service.foo", "html_url"),
 					resource.TestCheckResourceAttr(
@@ -1194,9 +1467,9 @@ func TestAccPagerDutyService_ResponsePlay(t *testing.T) {
 	})
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceWithNullResponsePlayConfig(username, email, escalationPolicy, responsePlay, service),
@@ -1216,12 +1489,8 @@ func TestAccPagerDutyService_ResponsePlay(t *testing.T) {
 						"pagerduty_service.foo", "alert_grouping"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "alert_grouping_timeout", "null"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.#", "1"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "high"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckNoResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type"),
 					resource.TestCheckResourceAttrSet(
 						"pagerduty_service.foo", "html_url"),
 					resource.TestCheckResourceAttr(
@@ -1244,9 +1513,9 @@ func TestAccPagerDutyService_AlertGroupingParametersAddConfigField(t *testing.T)
 	fieldsUpdated := []string{"custom_details.alert_name", "custom_details.stage"}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyServiceConfigWithConfigFields(
@@ -1273,7 +1542,218 @@ func TestAccPagerDutyService_AlertGroupingParametersAddConfigField(t *testing.T)
 	})
 }
 
-func testAccCheckPagerDutyServiceSaveServiceId(p *string, n string) resource.TestCheckFunc {
+func TestAccPagerDutyService_IncidentUrgencyRules(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  incident_urgency_rule {
+    type    = "use_support_hours"
+    urgency = "high"
+  }
+`),
+				ExpectError: regexp.MustCompile("general urgency cannot be set for a use_support_hours incident urgency rule type"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, testAccPagerDutyServiceSupportHoursConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "incident_urgency_rule.0.type", "use_support_hours"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "incident_urgency_rule.0.during_support_hours.0.urgency", "high"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "incident_urgency_rule.0.outside_support_hours.0.urgency", "low"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "support_hours.0.time_zone", "America/Lima"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "support_hours.0.days_of_week.#", "5"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "scheduled_actions.#", "1"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "scheduled_actions.0.at.0.name", "support_hours_start"),
+				),
+			},
+			{
+				Config:           testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, testAccPagerDutyServiceSupportHoursConfig),
+				ConfigPlanChecks: resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "low"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "incident_urgency_rule.0.urgency", "low"),
+					resource.TestCheckNoResourceAttr("pagerduty_service.foo", "support_hours.0.type"),
+					resource.TestCheckNoResourceAttr("pagerduty_service.foo", "scheduled_actions.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_AlertGroupingParameters(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  alert_grouping_parameters {
+    type = "content_based"
+  }
+`),
+				ExpectError: regexp.MustCompile(`attributes "aggregate" and "fields" are required`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  alert_grouping_parameters {
+    type = "content_based"
+    config {
+      aggregate   = "all"
+      fields      = ["custom_details.field1"]
+      time_window = 86400
+    }
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.type", "content_based"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.config.0.aggregate", "all"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.config.0.fields.#", "1"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.config.0.time_window", "86400"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  alert_grouping_parameters {
+    type = "time"
+    config {
+      timeout = 5
+    }
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.type", "time"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.config.0.timeout", "5"),
+					resource.TestCheckNoResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.config.0.aggregate"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pagerduty_service.foo", "alert_grouping_parameters.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_DeletionProtection(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, "deletion_protection = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, "deletion_protection = true"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection enabled"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, "deletion_protection = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_SDKv2Compatibility(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	config := testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, testAccPagerDutyServiceSupportHoursConfig)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "name", service),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "support_hours.0.time_zone", "America/Lima"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "support_hours.0.time_zone", "America/Lima"),
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "scheduled_actions.0.at.0.name", "support_hours_start"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_SDKv2CompatibilityWithoutIncidentUrgencyRule(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	config := testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, "")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: testAccExternalProviders(),
+				Config:            config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+				Config:                   config,
+				ConfigPlanChecks:         resource.ConfigPlanChecks{PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()}},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pagerduty_service.foo", "incident_urgency_rule.0.type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -1284,9 +1764,7 @@ func testAccCheckPagerDutyServiceSaveServiceId(p *string, n string) resource.Tes
 			return fmt.Errorf("No Service ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		found, _, err := client.Services.Get(rs.Primary.ID, &pagerduty.GetServiceOptions{})
+		found, err := testAccProvider.client.GetServiceWithContext(context.Background(), rs.Primary.ID, &pagerduty.GetServiceOptions{})
 		if err != nil {
 			return err
 		}
@@ -1295,28 +1773,57 @@ func testAccCheckPagerDutyServiceSaveServiceId(p *string, n string) resource.Tes
 			return fmt.Errorf("Service not found: %v - %v", rs.Primary.ID, found)
 		}
 
-		*p = found.ID
-
 		return nil
 	}
 }
 
 func testAccCheckPagerDutyServiceDestroy(s *terraform.State) error {
-	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
 		if r.Type != "pagerduty_service" {
 			continue
 		}
 
-		if _, _, err := client.Services.Get(r.Primary.ID, &pagerduty.GetServiceOptions{}); err == nil {
+		ctx := context.Background()
+		if _, err := testAccProvider.client.GetServiceWithContext(ctx, r.Primary.ID, &pagerduty.GetServiceOptions{}); err == nil {
 			return fmt.Errorf("Service still exists")
 		}
-
 	}
 	return nil
 }
 
-func testAccCheckPagerDutyServiceExists(n string) resource.TestCheckFunc {
+const testAccPagerDutyServiceSupportHoursConfig = `
+  incident_urgency_rule {
+    type = "use_support_hours"
+
+    during_support_hours {
+      type    = "constant"
+      urgency = "high"
+    }
+    outside_support_hours {
+      type    = "constant"
+      urgency = "low"
+    }
+  }
+
+  support_hours {
+    type         = "fixed_time_per_day"
+    time_zone    = "America/Lima"
+    start_time   = "09:00:00"
+    end_time     = "17:00:00"
+    days_of_week = [1, 2, 3, 4, 5]
+  }
+
+  scheduled_actions {
+    type       = "urgency_change"
+    to_urgency = "high"
+    at {
+      type = "named_time"
+      name = "support_hours_start"
+    }
+  }
+`
+
+func testAccCheckPagerDutyServiceSaveServiceId(p *string, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -1327,9 +1834,7 @@ func testAccCheckPagerDutyServiceExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Service ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		found, _, err := client.Services.Get(rs.Primary.ID, &pagerduty.GetServiceOptions{})
+		found, err := testAccProvider.client.GetServiceWithContext(context.Background(), rs.Primary.ID, &pagerduty.GetServiceOptions{})
 		if err != nil {
 			return err
 		}
@@ -1338,6 +1843,8 @@ func testAccCheckPagerDutyServiceExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Service not found: %v - %v", rs.Primary.ID, found)
 		}
 
+		*p = found.ID
+
 		return nil
 	}
 }
@@ -1353,9 +1860,7 @@ func testAccCheckPagerDutyServiceResponsePlayNotExist(n string) resource.TestChe
 			return fmt.Errorf("No Service ID is set")
 		}
 
-		client, _ := testAccProvider.Meta().(*Config).Client()
-
-		found, _, err := client.Services.Get(rs.Primary.ID, &pagerduty.GetServiceOptions{})
+		found, err := testAccProvider.client.GetServiceWithContext(context.Background(), rs.Primary.ID, &pagerduty.GetServiceOptions{})
 		if err != nil {
 			return err
 		}
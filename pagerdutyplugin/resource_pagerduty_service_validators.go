@@ -0,0 +1,221 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serviceTimeOfDayRegexp matches the start and end times of support hours.
+var serviceTimeOfDayRegexp = regexp.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`)
+
+var (
+	serviceUrgencies            = []string{"high", "low", "severity_based"}
+	serviceScheduledActionTimes = []string{"support_hours_start", "support_hours_end"}
+)
+
+// validateServiceUrgencyConfig checks the incident urgency rule, support hours
+// and scheduled actions of a service together, as the API does when they are
+// sent. Unknown values are skipped, they are checked once known.
+func validateServiceUrgencyConfig(ctx context.Context, model *resourceServiceModel, diags *diag.Diagnostics) {
+	rulePath := path.Root("incident_urgency_rule").AtListIndex(0)
+	rule, hasRule := firstListElement[serviceIncidentUrgencyRuleModel](ctx, model.IncidentUrgencyRule, diags)
+	useSupportHours := hasRule && rule.Type.ValueString() == "use_support_hours"
+
+	if hasRule {
+		switch {
+		case rule.Type.IsUnknown():
+		case rule.Type.IsNull():
+			diags.AddAttributeError(rulePath.AtName("type"), "Missing required argument",
+				`The argument "type" is required when incident_urgency_rule is set.`)
+		case rule.Type.ValueString() == "constant":
+			validateServiceUrgency(rule.Urgency, rulePath.AtName("urgency"), "a constant incident urgency rule type", diags)
+			if len(rule.DuringSupportHours.Elements()) > 0 || len(rule.OutsideSupportHours.Elements()) > 0 {
+				diags.AddAttributeError(rulePath, "Invalid configuration",
+					"during_support_hours and outside_support_hours cannot be set for a constant incident urgency rule type")
+			}
+		case useSupportHours:
+			if !rule.Urgency.IsNull() {
+				diags.AddAttributeError(rulePath.AtName("urgency"), "Invalid configuration",
+					"general urgency cannot be set for a use_support_hours incident urgency rule type")
+			}
+			validateServiceIncidentUrgencyType(ctx, rule.DuringSupportHours, rulePath, "during_support_hours", diags)
+			validateServiceIncidentUrgencyType(ctx, rule.OutsideSupportHours, rulePath, "outside_support_hours", diags)
+			if len(model.SupportHours.Elements()) == 0 {
+				diags.AddAttributeError(path.Root("support_hours"), "Invalid configuration",
+					"when using type = use_support_hours in incident_urgency_rule you must specify support_hours")
+			}
+		default:
+			diags.AddAttributeError(rulePath.AtName("type"), "Invalid configuration",
+				fmt.Sprintf("incident urgency rule type must be constant or use_support_hours, got: %s", rule.Type.ValueString()))
+		}
+	}
+
+	if sh, ok := firstListElement[serviceSupportHoursModel](ctx, model.SupportHours, diags); ok {
+		if hasRule && isKnownValue(rule.Type) && !useSupportHours {
+			diags.AddAttributeError(path.Root("support_hours"), "Invalid configuration",
+				"support_hours can only be set for a use_support_hours incident urgency rule type")
+		}
+		validateServiceSupportHours(sh, diags)
+	}
+
+	if model.ScheduledActions.IsNull() || model.ScheduledActions.IsUnknown() {
+		return
+	}
+	actionsPath := path.Root("scheduled_actions")
+	if len(model.ScheduledActions.Elements()) > 0 {
+		if len(model.SupportHours.Elements()) == 0 || (hasRule && isKnownValue(rule.Type) && !useSupportHours) {
+			diags.AddAttributeError(actionsPath, "Invalid configuration",
+				"scheduled_actions can only be set along with support_hours and a use_support_hours incident urgency rule type")
+		} else if useSupportHours {
+			during, _ := firstListElement[serviceIncidentUrgencyTypeModel](ctx, rule.DuringSupportHours, diags)
+			outside, _ := firstListElement[serviceIncidentUrgencyTypeModel](ctx, rule.OutsideSupportHours, diags)
+			if isKnownValue(during.Urgency) && isKnownValue(outside.Urgency) && (during.Urgency.ValueString() != "high" || outside.Urgency.ValueString() != "low") {
+				diags.AddAttributeError(actionsPath, "Invalid configuration",
+					"scheduled_actions can only be set when the urgency is high during support hours and low outside support hours")
+			}
+		}
+	}
+
+	var actions []serviceScheduledActionModel
+	diags.Append(model.ScheduledActions.ElementsAs(ctx, &actions, false)...)
+	for i, action := range actions {
+		actionPath := actionsPath.AtListIndex(i)
+		if !action.Type.IsUnknown() && action.Type.ValueString() != "urgency_change" {
+			diags.AddAttributeError(actionPath.AtName("type"), "Invalid configuration",
+				"scheduled action type must be urgency_change")
+		}
+		if !action.ToUrgency.IsUnknown() && action.ToUrgency.ValueString() != "high" && action.ToUrgency.ValueString() != "low" {
+			diags.AddAttributeError(actionPath.AtName("to_urgency"), "Invalid configuration",
+				"scheduled action to_urgency must be high or low")
+		}
+
+		at, ok := firstListElement[serviceScheduledActionAtModel](ctx, action.At, diags)
+		if !ok {
+			if !action.At.IsUnknown() {
+				diags.AddAttributeError(actionPath.AtName("at"), "Missing required block",
+					"an at block is required for a scheduled action")
+			}
+			continue
+		}
+		if !at.Type.IsUnknown() && at.Type.ValueString() != "named_time" {
+			diags.AddAttributeError(actionPath.AtName("at").AtListIndex(0).AtName("type"), "Invalid configuration",
+				"scheduled action at type must be named_time")
+		}
+		if !at.Name.IsUnknown() && !slices.Contains(serviceScheduledActionTimes, at.Name.ValueString()) {
+			diags.AddAttributeError(actionPath.AtName("at").AtListIndex(0).AtName("name"), "Invalid configuration",
+				"scheduled action at name must be support_hours_start or support_hours_end")
+		}
+	}
+}
+
+// validateServiceIncidentUrgencyType checks the urgency during or outside
+// support hours, both required by a use_support_hours rule.
+func validateServiceIncidentUrgencyType(ctx context.Context, l types.List, rulePath path.Path, name string, diags *diag.Diagnostics) {
+	p := rulePath.AtName(name)
+	if l.IsUnknown() {
+		return
+	}
+	if len(l.Elements()) == 0 {
+		diags.AddAttributeError(p, "Missing required block",
+			fmt.Sprintf("%s is required for a use_support_hours incident urgency rule type", name))
+		return
+	}
+	urgencyType, ok := firstListElement[serviceIncidentUrgencyTypeModel](ctx, l, diags)
+	if !ok {
+		return
+	}
+	p = p.AtListIndex(0)
+	if !urgencyType.Type.IsUnknown() && urgencyType.Type.ValueString() != "constant" {
+		diags.AddAttributeError(p.AtName("type"), "Invalid configuration",
+			"the urgency type during or outside support hours must be constant")
+	}
+	validateServiceUrgency(urgencyType.Urgency, p.AtName("urgency"), "an urgency during or outside support hours", diags)
+}
+
+func validateServiceUrgency(urgency types.String, p path.Path, of string, diags *diag.Diagnostics) {
+	if urgency.IsUnknown() {
+		return
+	}
+	if urgency.IsNull() {
+		diags.AddAttributeError(p, "Missing required argument", fmt.Sprintf("urgency is required for %s", of))
+		return
+	}
+	if !slices.Contains(serviceUrgencies, urgency.ValueString()) {
+		diags.AddAttributeError(p, "Invalid configuration",
+			fmt.Sprintf("urgency must be high, low or severity_based, got: %s", urgency.ValueString()))
+	}
+}
+
+func validateServiceSupportHours(sh serviceSupportHoursModel, diags *diag.Diagnostics) {
+	shPath := path.Root("support_hours").AtListIndex(0)
+
+	if isKnownValue(sh.Type) && sh.Type.ValueString() != "fixed_time_per_day" {
+		diags.AddAttributeError(shPath.AtName("type"), "Invalid configuration",
+			"support hours type must be fixed_time_per_day")
+	}
+	if sh.TimeZone.IsNull() {
+		diags.AddAttributeError(shPath.AtName("time_zone"), "Missing required argument",
+			"time_zone is required for support hours")
+	}
+
+	validTimes := true
+	for _, t := range []struct {
+		name  string
+		value types.String
+	}{{"start_time", sh.StartTime}, {"end_time", sh.EndTime}} {
+		name, v := t.name, t.value
+		if v.IsNull() {
+			validTimes = false
+			diags.AddAttributeError(shPath.AtName(name), "Missing required argument",
+				fmt.Sprintf("%s is required for support hours", name))
+			continue
+		}
+		if v.IsUnknown() {
+			validTimes = false
+			continue
+		}
+		if !serviceTimeOfDayRegexp.MatchString(v.ValueString()) {
+			validTimes = false
+			diags.AddAttributeError(shPath.AtName(name), "Invalid configuration",
+				fmt.Sprintf("%s must be of 00:00:00 format, got: %s", name, v.ValueString()))
+		}
+	}
+	// Times of the day in the same format compare as strings.
+	if validTimes && sh.StartTime.ValueString() >= sh.EndTime.ValueString() {
+		diags.AddAttributeError(shPath.AtName("end_time"), "Invalid configuration",
+			fmt.Sprintf("support hours end_time %s must be after start_time %s", sh.EndTime.ValueString(), sh.StartTime.ValueString()))
+	}
+
+	if sh.DaysOfWeek.IsUnknown() {
+		return
+	}
+	if sh.DaysOfWeek.IsNull() || len(sh.DaysOfWeek.Elements()) == 0 {
+		diags.AddAttributeError(shPath.AtName("days_of_week"), "Missing required argument",
+			"days_of_week must contain at least one day for support hours")
+		return
+	}
+	seen := map[int64]bool{}
+	for i, elem := range sh.DaysOfWeek.Elements() {
+		day, ok := elem.(types.Int64)
+		if !ok || day.IsNull() || day.IsUnknown() {
+			continue
+		}
+		dayPath := shPath.AtName("days_of_week").AtListIndex(i)
+		if day.ValueInt64() < 1 || day.ValueInt64() > 7 {
+			diags.AddAttributeError(dayPath, "Invalid configuration",
+				fmt.Sprintf("days_of_week must be between 1 (Monday) and 7 (Sunday), got: %d", day.ValueInt64()))
+			continue
+		}
+		if seen[day.ValueInt64()] {
+			diags.AddAttributeError(dayPath, "Invalid configuration",
+				fmt.Sprintf("day %d is set more than once in days_of_week", day.ValueInt64()))
+		}
+		seen[day.ValueInt64()] = true
+	}
+}
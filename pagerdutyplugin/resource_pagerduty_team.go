@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -18,11 +19,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
-type resourceTeam struct{ client *pagerduty.Client }
+type resourceTeam struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTeam)(nil)
 	_ resource.ResourceWithImportState = (*resourceTeam)(nil)
+	_ resource.ResourceWithModifyPlan  = (*resourceTeam)(nil)
 )
 
 func (r *resourceTeam) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,10 +58,56 @@ func (r *resourceTeam) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"parent": schema.StringAttribute{Optional: true},
+			"ignore_default_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"tags_all": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func (r *resourceTeam) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var ignore types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ignore_default_tags"), &ignore)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), planDefaultTags(r.config, ignore))...)
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if deletionProtection.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), planDeletionProtection(r.config))...)
+	}
+
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var parent types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parent"), &parent)...)
+	abilities := []string{"teams"}
+	if !parent.IsNull() {
+		abilities = append(abilities, "team_hierarchy")
+	}
+	checkPreflight(r.config, "pagerduty_team", "teams.write", abilities, &resp.Diagnostics)
+}
+
 func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model resourceTeamModel
 
@@ -79,13 +130,14 @@ func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, r
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error creating PagerDuty team %s", plan.Name),
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error creating PagerDuty team %s", plan.Name), err)
 		return
 	}
 
+	tagsAll := model.TagsAll
+	ignoreDefaultTags := model.IgnoreDefaultTags
+	deletionProtection := model.DeletionProtection
+
 	retryNotFound := true
 	model, err = requestGetTeam(ctx, r.client, plan, retryNotFound)
 	if err != nil {
@@ -95,6 +147,11 @@ func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
+
+	applyDefaultTags(ctx, r.client, "teams", plan.ID, types.SetNull(types.StringType), tagsAll, &resp.Diagnostics)
+	model.IgnoreDefaultTags = ignoreDefaultTags
+	model.TagsAll = tagsAll
+	model.DeletionProtection = deletionProtection
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -108,6 +165,15 @@ func (r *resourceTeam) Read(ctx context.Context, req resource.ReadRequest, resp
 	log.Printf("[INFO] Reading PagerDuty team %s", state.ID)
 
 	plan := buildPagerdutyTeam(&state)
+	tagsAll := state.TagsAll
+	ignoreDefaultTags := state.IgnoreDefaultTags
+	if ignoreDefaultTags.IsNull() {
+		ignoreDefaultTags = types.BoolValue(false)
+	}
+	deletionProtection := state.DeletionProtection
+	if deletionProtection.IsNull() {
+		deletionProtection = types.BoolValue(false)
+	}
 
 	retryNotFound := false
 	state, err := requestGetTeam(ctx, r.client, plan, retryNotFound)
@@ -122,6 +188,10 @@ func (r *resourceTeam) Read(ctx context.Context, req resource.ReadRequest, resp
 		)
 		return
 	}
+
+	state.IgnoreDefaultTags = ignoreDefaultTags
+	state.DeletionProtection = deletionProtection
+	state.TagsAll = readDefaultTags(ctx, r.client, "teams", plan.ID, tagsAll, planDefaultTags(r.config, ignoreDefaultTags), &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -139,6 +209,15 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 		req.State.GetAttribute(ctx, path.Root("id"), &id)
 		plan.ID = id
 	}
+	tagsAll := model.TagsAll
+	ignoreDefaultTags := model.IgnoreDefaultTags
+	deletionProtection := model.DeletionProtection
+
+	var prevTagsAll types.Set
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &prevTagsAll)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	log.Printf("[INFO] Updating PagerDuty team %s", plan.ID)
 
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
@@ -153,10 +232,7 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error updating PagerDuty team %s", plan.ID),
-			err.Error(),
-		)
+		addAPIError(ctx, &resp.Diagnostics, r, fmt.Sprintf("Error updating PagerDuty team %s", plan.ID), err)
 		return
 	}
 
@@ -173,13 +249,24 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 		return
 	}
+
+	applyDefaultTags(ctx, r.client, "teams", plan.ID, prevTagsAll, tagsAll, &resp.Diagnostics)
+	model.IgnoreDefaultTags = ignoreDefaultTags
+	model.TagsAll = tagsAll
+	model.DeletionProtection = deletionProtection
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
 func (r *resourceTeam) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var id types.String
+	var deletionProtection types.Bool
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkDeletionProtection("pagerduty_team", id.ValueString(), deletionProtection, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -208,6 +295,7 @@ func (r *resourceTeam) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *resourceTeam) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
 func (r *resourceTeam) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -221,6 +309,11 @@ type resourceTeamModel struct {
 	Description types.String `tfsdk:"description"`
 	HTMLURL     types.String `tfsdk:"html_url"`
 	Parent      types.String `tfsdk:"parent"`
+
+	IgnoreDefaultTags types.Bool `tfsdk:"ignore_default_tags"`
+	TagsAll           types.Set  `tfsdk:"tags_all"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 func requestGetTeam(ctx context.Context, client *pagerduty.Client, plan *pagerduty.Team, retryNotFound bool) (resourceTeamModel, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -160,6 +161,78 @@ func TestAccPagerDutyTeam_Parent(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTeam_DefaultTags(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	tag := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	tagUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamDefaultTagsConfig(team, tag, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "tags_all.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"pagerduty_team.foo", "tags_all.*", tag),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamDefaultTagsConfig(team, tagUpdated, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "tags_all.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"pagerduty_team.foo", "tags_all.*", tagUpdated),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamDefaultTagsConfig(team, tagUpdated, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "tags_all.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyTeam_DeletionProtection(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamDeletionProtectionConfig(team, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyTeamDeletionProtectionConfig(team, ""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection enabled"),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamDeletionProtectionConfig(team, "deletion_protection = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyTeamDestroy(s *terraform.State) error {
 	ctx := context.Background()
 
@@ -229,6 +302,32 @@ resource "pagerduty_team" "foo" {
 }`, parent, team)
 }
 
+func testAccCheckPagerDutyTeamDefaultTagsConfig(team, tag string, ignore bool) string {
+	return fmt.Sprintf(`
+provider "pagerduty" {
+  default_tags = ["%s"]
+}
+
+resource "pagerduty_team" "foo" {
+  name                = "%s"
+  ignore_default_tags = %t
+}
+`, tag, team, ignore)
+}
+
+func testAccCheckPagerDutyTeamDeletionProtectionConfig(team, extra string) string {
+	return fmt.Sprintf(`
+provider "pagerduty" {
+  default_deletion_protection = true
+}
+
+resource "pagerduty_team" "foo" {
+  name = "%s"
+  %s
+}
+`, team, extra)
+}
+
 func testAccExternallyDestroyTeam(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
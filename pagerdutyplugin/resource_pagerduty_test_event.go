@@ -0,0 +1,407 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+// testEventPollInterval is how often incidents and alerts are looked up while
+// waiting for the outcome of a test event.
+var testEventPollInterval = 2 * time.Second
+
+// resourceTestEvent sends a trigger event through the Events API v2 and
+// waits for PagerDuty to process it, as a smoke test of the services and
+// integrations configured. Nothing is kept in PagerDuty, the event is
+// resolved once processed.
+type resourceTestEvent struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure      = (*resourceTestEvent)(nil)
+	_ resource.ResourceWithModifyPlan     = (*resourceTestEvent)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceTestEvent)(nil)
+)
+
+func (r *resourceTestEvent) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceTestEvent) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_test_event"
+}
+
+func (r *resourceTestEvent) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"routing_key": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"summary": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("Test event sent by Terraform"),
+			},
+			"source": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("terraform"),
+			},
+			"severity": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("info"),
+				Validators: []validator.String{stringvalidator.OneOf("critical", "error", "warning", "info")},
+			},
+			"expect": schema.StringAttribute{
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("incident"),
+				Validators: []validator.String{stringvalidator.OneOf("incident", "suppressed")},
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("5m"),
+			},
+			"dedup_key":       schema.StringAttribute{Computed: true},
+			"outcome":         schema.StringAttribute{Computed: true},
+			"incident_id":     schema.StringAttribute{Computed: true},
+			"incident_status": schema.StringAttribute{Computed: true},
+			"alert_id":        schema.StringAttribute{Computed: true},
+			"service":         schema.StringAttribute{Computed: true},
+			"latency_ms":      schema.Int64Attribute{Computed: true},
+			"triggered_at":    schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (r *resourceTestEvent) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if !isKnownValue(timeout) {
+		return
+	}
+	if d, err := time.ParseDuration(timeout.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid configuration",
+			fmt.Sprintf("timeout must be a positive duration like 5m, got: %s", timeout.ValueString()))
+	}
+}
+
+// ModifyPlan keeps the outcome of the test event sent last unless
+// `triggers` change, as a new test event is only sent then.
+func (r *resourceTestEvent) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceTestEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !plan.Triggers.Equal(state.Triggers) {
+		return
+	}
+	plan.keepOutcome(state)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *resourceTestEvent) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceTestEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.ID = types.StringValue(id.UniqueId())
+	resp.Diagnostics.Append(r.sendTestEvent(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Read refreshes the outcome of the test event sent last, whose incident may
+// have been acknowledged or resolved since.
+func (r *resourceTestEvent) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model resourceTestEventModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggeredAt, err := time.Parse(time.RFC3339, model.TriggeredAt.ValueString())
+	if model.DedupKey.ValueString() == "" || err != nil {
+		return
+	}
+
+	result, err := r.lookupTestEvent(ctx, model.DedupKey.ValueString(), triggeredAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading PagerDuty test event", err.Error())
+		return
+	}
+	// Incidents and alerts past the retention of the account aren't found
+	// anymore, the outcome known last is kept then.
+	if result == nil {
+		return
+	}
+	model.setResult(result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Update sends a new test event only when `triggers` change, other
+// arguments describe the next test event to send.
+func (r *resourceTestEvent) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model, state resourceTestEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Triggers.Equal(state.Triggers) {
+		model.keepOutcome(state)
+	} else {
+		resp.Diagnostics.Append(r.sendTestEvent(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceTestEvent) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+// sendTestEvent triggers an event, waits for the incident it creates or the
+// alert it's suppressed as, and resolves it.
+func (r *resourceTestEvent) sendTestEvent(ctx context.Context, model *resourceTestEventModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeout, err := time.ParseDuration(model.Timeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("timeout"), "Invalid configuration", err.Error())
+		return diags
+	}
+
+	dedupKey := id.PrefixedUniqueId("terraform-test-event-")
+	triggeredAt := time.Now().UTC()
+	log.Printf("[INFO] Sending PagerDuty test event %s", dedupKey)
+
+	event := &pagerduty.V2Event{
+		RoutingKey: model.RoutingKey.ValueString(),
+		Action:     "trigger",
+		DedupKey:   dedupKey,
+		Client:     "Terraform",
+		Payload: &pagerduty.V2Payload{
+			Summary:   model.Summary.ValueString(),
+			Source:    model.Source.ValueString(),
+			Severity:  model.Severity.ValueString(),
+			Timestamp: triggeredAt.Format(time.RFC3339),
+		},
+	}
+	if _, err := r.client.ManageEventWithContext(ctx, event); err != nil {
+		diags.AddError("Error sending PagerDuty test event", err.Error())
+		return diags
+	}
+
+	result, err := r.waitForTestEvent(ctx, dedupKey, triggeredAt, timeout)
+	latency := time.Since(triggeredAt)
+
+	// Resolving is attempted in any case, so that a test event that failed
+	// doesn't keep paging.
+	resolve := &pagerduty.V2Event{RoutingKey: event.RoutingKey, Action: "resolve", DedupKey: dedupKey}
+	if _, resolveErr := r.client.ManageEventWithContext(ctx, resolve); resolveErr != nil {
+		diags.AddWarning("Error resolving test event", fmt.Sprintf("Test event %s couldn't be resolved: %s", dedupKey, resolveErr))
+	}
+
+	if err != nil {
+		diags.AddError("Error waiting for the test event", err.Error())
+		return diags
+	}
+	if result == nil {
+		diags.AddError("Test event not processed",
+			fmt.Sprintf("Neither an incident nor a suppressed alert was created for test event %s within %s.", dedupKey, timeout))
+		return diags
+	}
+
+	model.DedupKey = types.StringValue(dedupKey)
+	model.TriggeredAt = types.StringValue(triggeredAt.Format(time.RFC3339))
+	model.LatencyMs = types.Int64Value(latency.Milliseconds())
+	model.setResult(result)
+
+	switch {
+	case model.Expect.ValueString() == "suppressed" && result.incident != nil:
+		diags.AddError("Test event not suppressed",
+			fmt.Sprintf("Test event %s was expected to be suppressed, but created incident %s on service %s.", dedupKey, result.incident.ID, result.incident.Service.ID))
+	case model.Expect.ValueString() == "incident" && result.incident == nil:
+		diags.AddError("Test event suppressed",
+			fmt.Sprintf("Test event %s was expected to create an incident, but was suppressed as alert %s on service %s.", dedupKey, result.alert.ID, result.alert.Service.ID))
+	}
+	return diags
+}
+
+// testEventResult is what PagerDuty did with a test event: either the
+// incident it created, along with its alert when found, or the suppressed
+// alert it created instead.
+type testEventResult struct {
+	incident *pagerduty.Incident
+	alert    *pagerduty.IncidentAlert
+}
+
+// waitForTestEvent polls for the outcome of a test event until it's found.
+// It returns no outcome once the timeout elapses.
+func (r *resourceTestEvent) waitForTestEvent(ctx context.Context, dedupKey string, since time.Time, timeout time.Duration) (*testEventResult, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		result, err := r.lookupTestEvent(ctx, dedupKey, since)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return nil, err
+			}
+			log.Printf("[WARN] Looking for the outcome of test event %s: %s", dedupKey, err)
+		} else if result != nil {
+			return result, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(testEventPollInterval):
+		}
+	}
+}
+
+// lookupTestEvent looks for the incident created by a test event, whose
+// incident key is the dedup key of the event, and otherwise for the
+// suppressed alert with its dedup key. It returns no outcome when neither
+// exists yet.
+func (r *resourceTestEvent) lookupTestEvent(ctx context.Context, dedupKey string, since time.Time) (*testEventResult, error) {
+	incidents, err := r.client.ListIncidentsWithContext(ctx, pagerduty.ListIncidentsOptions{
+		IncidentKey: dedupKey,
+		Since:       since.Add(-time.Minute).Format(time.RFC3339),
+		Limit:       1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(incidents.Incidents) > 0 {
+		result := &testEventResult{incident: &incidents.Incidents[0]}
+		alerts, err := r.client.ListIncidentAlertsWithContext(ctx, result.incident.ID, pagerduty.ListIncidentAlertsOptions{})
+		if err != nil {
+			log.Printf("[WARN] Couldn't list alerts of incident %s: %s", result.incident.ID, err)
+			return result, nil
+		}
+		result.alert = findTestEventAlert(alerts.Alerts, dedupKey, false)
+		return result, nil
+	}
+
+	// Suppressed alerts belong to no incident, so they're listed by the key
+	// of the alert.
+	var alerts pagerduty.ListAlertsResponse
+	query := url.Values{
+		"alert_key": {dedupKey},
+		"since":     {since.Add(-time.Minute).Format(time.RFC3339)},
+	}
+	if err := apiRequest(ctx, r.client, r.config.apiURL(), http.MethodGet, "/alerts?"+query.Encode(), nil, &alerts); err != nil {
+		return nil, err
+	}
+	if alert := findTestEventAlert(alerts.Alerts, dedupKey, true); alert != nil {
+		return &testEventResult{alert: alert}, nil
+	}
+	return nil, nil
+}
+
+// findTestEventAlert finds the alert of a test event among alerts.
+func findTestEventAlert(alerts []pagerduty.IncidentAlert, dedupKey string, suppressed bool) *pagerduty.IncidentAlert {
+	for i, alert := range alerts {
+		if alert.AlertKey == dedupKey && (!suppressed || alert.Suppressed) {
+			return &alerts[i]
+		}
+	}
+	return nil
+}
+
+type resourceTestEventModel struct {
+	ID             types.String `tfsdk:"id"`
+	RoutingKey     types.String `tfsdk:"routing_key"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	Summary        types.String `tfsdk:"summary"`
+	Source         types.String `tfsdk:"source"`
+	Severity       types.String `tfsdk:"severity"`
+	Expect         types.String `tfsdk:"expect"`
+	Timeout        types.String `tfsdk:"timeout"`
+	DedupKey       types.String `tfsdk:"dedup_key"`
+	Outcome        types.String `tfsdk:"outcome"`
+	IncidentID     types.String `tfsdk:"incident_id"`
+	IncidentStatus types.String `tfsdk:"incident_status"`
+	AlertID        types.String `tfsdk:"alert_id"`
+	Service        types.String `tfsdk:"service"`
+	LatencyMs      types.Int64  `tfsdk:"latency_ms"`
+	TriggeredAt    types.String `tfsdk:"triggered_at"`
+}
+
+// setResult sets the outcome of a test event.
+func (m *resourceTestEventModel) setResult(result *testEventResult) {
+	m.Outcome = types.StringValue("suppressed")
+	m.IncidentID = types.StringNull()
+	m.IncidentStatus = types.StringNull()
+	m.AlertID = types.StringNull()
+	m.Service = types.StringNull()
+
+	if result.alert != nil {
+		m.AlertID = types.StringValue(result.alert.ID)
+		m.Service = types.StringValue(result.alert.Service.ID)
+	}
+	if result.incident != nil {
+		m.Outcome = types.StringValue("incident")
+		m.IncidentID = types.StringValue(result.incident.ID)
+		m.IncidentStatus = types.StringValue(result.incident.Status)
+		m.Service = types.StringValue(result.incident.Service.ID)
+	}
+}
+
+// keepOutcome copies the outcome of the test event sent last from state.
+func (m *resourceTestEventModel) keepOutcome(state resourceTestEventModel) {
+	m.DedupKey = state.DedupKey
+	m.Outcome = state.Outcome
+	m.IncidentID = state.IncidentID
+	m.IncidentStatus = state.IncidentStatus
+	m.AlertID = state.AlertID
+	m.Service = state.Service
+	m.LatencyMs = state.LatencyMs
+	m.TriggeredAt = state.TriggeredAt
+}
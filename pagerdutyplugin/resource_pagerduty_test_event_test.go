@@ -0,0 +1,171 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// TestWaitForTestEventSuppressed checks that a suppressed test event is
+// found by its alert as soon as it shows up, and that an event without any
+// outcome isn't taken for a suppressed one.
+func TestWaitForTestEventSuppressed(t *testing.T) {
+	var alerts string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/incidents":
+			fmt.Fprint(w, `{"incidents":[]}`)
+		case "/alerts":
+			fmt.Fprintf(w, `{"alerts":[%s]}`, alerts)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		APIURLOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+	ctx := context.Background()
+	client, err := config.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &resourceTestEvent{client: client, config: config}
+
+	result, err := r.waitForTestEvent(ctx, "K1", time.Now(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != nil {
+		t.Fatalf("expected no outcome without a suppressed alert, got: %+v", result)
+	}
+
+	alerts = `{"id":"A0","alert_key":"K0","suppressed":true},{"id":"A1","alert_key":"K1","suppressed":true,"service":{"id":"S1"}}`
+	start := time.Now()
+	result, err = r.waitForTestEvent(ctx, "K1", time.Now(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) > time.Minute {
+		t.Error("expected the suppressed alert to be found without waiting out the timeout")
+	}
+	if result == nil || result.incident != nil || result.alert == nil || result.alert.ID != "A1" {
+		t.Fatalf("expected suppressed alert A1, got: %+v", result)
+	}
+
+	var model resourceTestEventModel
+	model.setResult(result)
+	if model.Outcome.ValueString() != "suppressed" || model.Service.ValueString() != "S1" || !model.IncidentID.IsNull() {
+		t.Errorf("unexpected outcome: %+v", model)
+	}
+}
+
+func TestAccPagerDutyTestEvent_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTestEventConfig(username, email, escalationPolicy, service, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_test_event.foo", "outcome", "incident"),
+					resource.TestCheckResourceAttrPair("pagerduty_test_event.foo", "service", "pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttrSet("pagerduty_test_event.foo", "incident_id"),
+					resource.TestCheckResourceAttrSet("pagerduty_test_event.foo", "dedup_key"),
+					resource.TestCheckResourceAttrSet("pagerduty_test_event.foo", "latency_ms"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTestEventConfig(username, email, escalationPolicy, service, "v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("pagerduty_test_event.foo", tfjsonpath.New("dedup_key")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_test_event.foo", "outcome", "incident"),
+					resource.TestCheckResourceAttr("pagerduty_test_event.foo", "triggers.version", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyTestEvent_InvalidTimeout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "pagerduty_test_event" "foo" {
+  routing_key = "R0UT1NGK3Y"
+  timeout     = "five minutes"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("timeout must be a positive duration"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyTestEventConfig(username, email, escalationPolicy, service, version string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+  alert_creation    = "create_alerts_and_incidents"
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name    = "Events API v2"
+  service = pagerduty_service.foo.id
+  type    = "events_api_v2_inbound_integration"
+}
+
+resource "pagerduty_test_event" "foo" {
+  routing_key = pagerduty_service_integration.foo.integration_key
+  triggers = {
+    version = "%s"
+  }
+}
+`, username, email, escalationPolicy, service, version)
+}
@@ -0,0 +1,222 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/PagerDuty/go-pagerduty"
+	legacy "github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// APIErrorKind classifies an error returned by the PagerDuty API according
+// to what the user can do to solve it.
+type APIErrorKind int
+
+const (
+	APIErrorKindUnknown APIErrorKind = iota
+	APIErrorKindAuth
+	APIErrorKindMissingScope
+	APIErrorKindRateLimit
+	APIErrorKindValidation
+	APIErrorKindNotFound
+	APIErrorKindReadOnly
+)
+
+func (k APIErrorKind) String() string {
+	switch k {
+	case APIErrorKindAuth:
+		return "authentication"
+	case APIErrorKindMissingScope:
+		return "missing scope"
+	case APIErrorKindRateLimit:
+		return "rate limit"
+	case APIErrorKindValidation:
+		return "validation"
+	case APIErrorKindNotFound:
+		return "not found"
+	case APIErrorKindReadOnly:
+		return "read only"
+	}
+	return "unknown"
+}
+
+// APIErrorDetails is the structured content of an error response of the
+// PagerDuty API, regardless of the API client which received it.
+type APIErrorDetails struct {
+	Kind       APIErrorKind
+	StatusCode int
+	Code       int
+	Message    string
+	Errors     []string
+
+	// Scopes required by the request, only reported by the API for OAuth
+	// scoped tokens.
+	RequiredScopes string
+}
+
+// ParseAPIError extracts the details of an error response of the PagerDuty
+// API from an error returned by any of the API clients used by the provider.
+// It returns false when `err` doesn't wrap an API error.
+func ParseAPIError(err error) (*APIErrorDetails, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var details *APIErrorDetails
+
+	var apiErr pagerduty.APIError
+	var legacyErr *legacy.Error
+	switch {
+	case errors.As(err, &apiErr):
+		details = &APIErrorDetails{StatusCode: apiErr.StatusCode}
+		if apiErr.APIError.Valid {
+			details.Code = apiErr.APIError.ErrorObject.Code
+			details.Message = apiErr.APIError.ErrorObject.Message
+			details.Errors = apiErr.APIError.ErrorObject.Errors
+		}
+	case errors.As(err, &legacyErr):
+		details = &APIErrorDetails{
+			Code:           legacyErr.Code,
+			Message:        legacyErr.Message,
+			Errors:         flattenLegacyErrors(legacyErr.Errors),
+			RequiredScopes: legacyErr.RequiredScopes,
+		}
+		if legacyErr.ErrorResponse != nil && legacyErr.ErrorResponse.Response != nil {
+			details.StatusCode = legacyErr.ErrorResponse.Response.StatusCode
+		}
+	default:
+		return nil, false
+	}
+
+	details.Kind = details.classify()
+	return details, true
+}
+
+func flattenLegacyErrors(v interface{}) []string {
+	switch errs := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{errs}
+	case []string:
+		return errs
+	case []interface{}:
+		list := make([]string, 0, len(errs))
+		for _, e := range errs {
+			list = append(list, fmt.Sprint(e))
+		}
+		return list
+	}
+	return []string{fmt.Sprint(v)}
+}
+
+func (e *APIErrorDetails) classify() APIErrorKind {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return APIErrorKindAuth
+	case http.StatusForbidden:
+		if e.RequiredScopes != "" || e.mentions("scope") {
+			return APIErrorKindMissingScope
+		}
+		return APIErrorKindAuth
+	case http.StatusTooManyRequests:
+		return APIErrorKindRateLimit
+	case http.StatusNotFound:
+		return APIErrorKindNotFound
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		if e.Code == ReadOnlyErrorCode {
+			return APIErrorKindReadOnly
+		}
+		return APIErrorKindValidation
+	}
+	return APIErrorKindUnknown
+}
+
+func (e *APIErrorDetails) mentions(s string) bool {
+	if strings.Contains(strings.ToLower(e.Message), s) {
+		return true
+	}
+	for _, msg := range e.Errors {
+		if strings.Contains(strings.ToLower(msg), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Hint describes what the user can do to solve the error.
+func (e *APIErrorDetails) Hint() string {
+	switch e.Kind {
+	case APIErrorKindAuth:
+		return "The PagerDuty API rejected the credentials of the provider. Check the token is valid and its user has permission over this object."
+	case APIErrorKindMissingScope:
+		if e.RequiredScopes != "" {
+			return fmt.Sprintf("The OAuth token of the provider lacks the scopes required by this request: %s.", e.RequiredScopes)
+		}
+		return "The OAuth token of the provider lacks the scopes required by this request."
+	case APIErrorKindRateLimit:
+		return "The PagerDuty API rate limit was exceeded. Try again later or reduce the parallelism of Terraform with `-parallelism`."
+	case APIErrorKindValidation:
+		return "The PagerDuty API rejected the configuration of this object."
+	case APIErrorKindReadOnly:
+		return readOnlyHint
+	}
+	return ""
+}
+
+// TranslateAPIError adds to an error of the PagerDuty API the hint of what
+// the user can do to solve it, keeping the original error wrapped. Errors not
+// coming from the API, or already translated, are returned as is.
+func TranslateAPIError(err error) error {
+	var translated *translatedAPIError
+	if errors.As(err, &translated) {
+		return err
+	}
+	details, ok := ParseAPIError(err)
+	if !ok || details.Hint() == "" {
+		return err
+	}
+	return &translatedAPIError{err: err, hint: details.Hint()}
+}
+
+type translatedAPIError struct {
+	err  error
+	hint string
+}
+
+func (e *translatedAPIError) Error() string {
+	return fmt.Sprintf("%s\n\n%s", e.err, e.hint)
+}
+
+func (e *translatedAPIError) Unwrap() error {
+	return e.err
+}
+
+// MatchAPIErrorAttribute returns the name of the attribute an error message
+// of the API refers to. PagerDuty reports validation errors prefixed with
+// the humanized name of the offending field, e.g. "Escalation policy can't
+// be blank" for `escalation_policy`.
+func MatchAPIErrorAttribute(message string, attributes []string) (string, bool) {
+	candidates := append([]string{}, attributes...)
+	// Prefer the longest match, so "name" doesn't shadow "name_format".
+	sort.Slice(candidates, func(i, j int) bool {
+		return len(candidates[i]) > len(candidates[j])
+	})
+
+	lower := strings.ToLower(strings.TrimSpace(message))
+	for _, name := range candidates {
+		for _, prefix := range []string{name, strings.ReplaceAll(name, "_", " ")} {
+			if !strings.HasPrefix(lower, prefix) {
+				continue
+			}
+			rest := lower[len(prefix):]
+			if rest == "" || rest[0] == ' ' || rest[0] == ':' {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,175 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+	legacy "github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func newTestAPIError(status int, message string, errs ...string) error {
+	return pagerduty.APIError{
+		StatusCode: status,
+		APIError: pagerduty.NullAPIErrorObject{
+			Valid: true,
+			ErrorObject: pagerduty.APIErrorObject{
+				Message: message,
+				Errors:  errs,
+			},
+		},
+	}
+}
+
+func newTestLegacyError(status int, e *legacy.Error) error {
+	e.ErrorResponse = &legacy.Response{
+		Response: &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/services"}},
+		},
+	}
+	return e
+}
+
+func TestParseAPIError(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		ok     bool
+		kind   APIErrorKind
+		errors []string
+	}{
+		{
+			name: "not an API error",
+			err:  errors.New("boom"),
+		},
+		{
+			name: "unauthorized",
+			err:  newTestAPIError(http.StatusUnauthorized, "Unauthorized"),
+			ok:   true,
+			kind: APIErrorKindAuth,
+		},
+		{
+			name: "missing scope",
+			err:  newTestAPIError(http.StatusForbidden, "Access Denied", "Required scope services.write is missing"),
+			ok:   true,
+			kind: APIErrorKindMissingScope,
+		},
+		{
+			name: "forbidden",
+			err:  newTestAPIError(http.StatusForbidden, "Access Denied"),
+			ok:   true,
+			kind: APIErrorKindAuth,
+		},
+		{
+			name: "rate limit",
+			err:  newTestAPIError(http.StatusTooManyRequests, "Rate Limit Exceeded"),
+			ok:   true,
+			kind: APIErrorKindRateLimit,
+		},
+		{
+			name:   "wrapped validation",
+			err:    fmt.Errorf("Error reading: P123: %w", newTestAPIError(http.StatusBadRequest, "Invalid Input Provided", "Name has already been taken")),
+			ok:     true,
+			kind:   APIErrorKindValidation,
+			errors: []string{"Name has already been taken"},
+		},
+		{
+			name: "legacy missing scope",
+			err: newTestLegacyError(http.StatusForbidden, &legacy.Error{
+				Message:        "Forbidden",
+				RequiredScopes: "services.write",
+			}),
+			ok:   true,
+			kind: APIErrorKindMissingScope,
+		},
+		{
+			name: "legacy validation",
+			err: newTestLegacyError(http.StatusBadRequest, &legacy.Error{
+				Code:    2001,
+				Message: "Invalid Input Provided",
+				Errors:  []interface{}{"Escalation policy can't be blank"},
+			}),
+			ok:     true,
+			kind:   APIErrorKindValidation,
+			errors: []string{"Escalation policy can't be blank"},
+		},
+		{
+			name: "read only",
+			err: newTestLegacyError(http.StatusBadRequest, &legacy.Error{
+				Code:    ReadOnlyErrorCode,
+				Message: "refused",
+			}),
+			ok:   true,
+			kind: APIErrorKindReadOnly,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			details, ok := ParseAPIError(c.err)
+			if ok != c.ok {
+				t.Fatalf("want ok %t; got %t", c.ok, ok)
+			}
+			if !ok {
+				return
+			}
+			if details.Kind != c.kind {
+				t.Errorf("want kind %q; got %q", c.kind, details.Kind)
+			}
+			if c.errors != nil && fmt.Sprint(details.Errors) != fmt.Sprint(c.errors) {
+				t.Errorf("want errors %v; got %v", c.errors, details.Errors)
+			}
+		})
+	}
+}
+
+func TestTranslateAPIError(t *testing.T) {
+	rateLimited := newTestAPIError(http.StatusTooManyRequests, "Rate Limit Exceeded")
+
+	translated := TranslateAPIError(rateLimited)
+	if !strings.HasPrefix(translated.Error(), rateLimited.Error()) || !strings.Contains(translated.Error(), "-parallelism") {
+		t.Errorf("expected the error followed by its hint, got %q", translated)
+	}
+	if details, ok := ParseAPIError(translated); !ok || details.Kind != APIErrorKindRateLimit {
+		t.Error("expected the translated error to wrap the API error")
+	}
+	if twice := TranslateAPIError(fmt.Errorf("Error reading: P123: %w", translated)); strings.Count(twice.Error(), "-parallelism") != 1 {
+		t.Errorf("expected the hint to be added once, got %q", twice)
+	}
+
+	if err := errors.New("boom"); TranslateAPIError(err) != err {
+		t.Error("expected errors not coming from the API as is")
+	}
+	if TranslateAPIError(nil) != nil {
+		t.Error("expected no error")
+	}
+}
+
+func TestMatchAPIErrorAttribute(t *testing.T) {
+	attributes := []string{"name", "name_format", "escalation_policy", "description"}
+
+	cases := []struct {
+		message string
+		want    string
+		ok      bool
+	}{
+		{message: "Name has already been taken", want: "name", ok: true},
+		{message: "Escalation policy can't be blank", want: "escalation_policy", ok: true},
+		{message: "name_format: is invalid", want: "name_format", ok: true},
+		{message: "Names must be unique"},
+		{message: "Service could not be locked"},
+	}
+
+	for _, c := range cases {
+		got, ok := MatchAPIErrorAttribute(c.message, attributes)
+		if ok != c.ok || got != c.want {
+			t.Errorf("%q: want (%q, %t); got (%q, %t)", c.message, c.want, c.ok, got, ok)
+		}
+	}
+}
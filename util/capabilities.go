@@ -0,0 +1,123 @@
+package util
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// AccountCapabilities caches the abilities of the PagerDuty account and the
+// OAuth scopes granted to the provider, so resources can tell at plan time
+// whether the API is going to reject them. Either of them is unknown until
+// set, in which case nothing is reported as missing.
+type AccountCapabilities struct {
+	mu        sync.RWMutex
+	abilities map[string]bool
+	scopes    map[string]bool
+}
+
+// SetAbilities records the abilities of the account as listed by the
+// `/abilities` endpoint.
+func (c *AccountCapabilities) SetAbilities(abilities []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.abilities = toSet(abilities)
+}
+
+// SetScopes records the OAuth scopes granted to the provider.
+func (c *AccountCapabilities) SetScopes(scopes []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.scopes = toSet(scopes)
+}
+
+// MissingAbility reports whether the account is known to lack an ability.
+func (c *AccountCapabilities) MissingAbility(ability string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.abilities != nil && !c.abilities[ability]
+}
+
+// MissingScope reports whether the provider is known to lack an OAuth scope.
+func (c *AccountCapabilities) MissingScope(scope string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.scopes != nil && !c.scopes[scope]
+}
+
+// Check returns an error naming the first ability or OAuth scope, out of the
+// ones needed to manage a resource of type `resourceType`, known to be
+// missing.
+func (c *AccountCapabilities) Check(resourceType, scope string, abilities ...string) error {
+	for _, ability := range abilities {
+		if c.MissingAbility(ability) {
+			return fmt.Errorf("%s requires the %q ability, which the PagerDuty account doesn't have. Check the plan of the account or contact PagerDuty support", resourceType, ability)
+		}
+	}
+	if scope != "" && c.MissingScope(scope) {
+		return fmt.Errorf("%s requires the %q OAuth scope, which wasn't granted to the provider. Add it to the scopes of the PagerDuty app used in `use_app_oauth_scoped_token`", resourceType, scope)
+	}
+	return nil
+}
+
+func toSet(list []string) map[string]bool {
+	set := make(map[string]bool, len(list))
+	for _, v := range list {
+		set[v] = true
+	}
+	return set
+}
+
+var (
+	grantedScopesMu sync.RWMutex
+	grantedScopes   = map[string][]string{}
+)
+
+// GrantedScopes returns the OAuth scopes granted to a PagerDuty app, as seen
+// in the last access token issued to it by this process, or false when no
+// token was issued.
+func GrantedScopes(clientID string) ([]string, bool) {
+	grantedScopesMu.RLock()
+	defer grantedScopesMu.RUnlock()
+	scopes, ok := grantedScopes[clientID]
+	return scopes, ok
+}
+
+// NewScopeRecordingTokenSource returns an oauth2.TokenSource which records
+// the scopes granted in each token issued by `ts` for GrantedScopes.
+func NewScopeRecordingTokenSource(clientID string, ts oauth2.TokenSource) oauth2.TokenSource {
+	return &scopeRecordingTokenSource{clientID: clientID, next: ts}
+}
+
+type scopeRecordingTokenSource struct {
+	clientID string
+	next     oauth2.TokenSource
+}
+
+func (s *scopeRecordingTokenSource) Token() (*oauth2.Token, error) {
+	tok, err := s.next.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only tokens issued in this process carry the response of the token
+	// endpoint, the ones reloaded from disk don't.
+	if raw, ok := tok.Extra("scope").(string); ok && raw != "" {
+		var scopes []string
+		for _, scope := range strings.Fields(raw) {
+			// The account is requested as a pseudo scope.
+			if !strings.HasPrefix(scope, "as_account-") {
+				scopes = append(scopes, scope)
+			}
+		}
+		log.Printf("[DEBUG] PagerDuty app %s was granted scopes: %v", s.clientID, scopes)
+
+		grantedScopesMu.Lock()
+		grantedScopes[s.clientID] = scopes
+		grantedScopesMu.Unlock()
+	}
+	return tok, nil
+}
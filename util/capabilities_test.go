@@ -0,0 +1,26 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAccountCapabilitiesCheck(t *testing.T) {
+	var c AccountCapabilities
+	if err := c.Check("pagerduty_team", "teams.write", "teams"); err != nil {
+		t.Fatalf("unknown capabilities must not be reported as missing, got %v", err)
+	}
+
+	c.SetAbilities([]string{"teams"})
+	if err := c.Check("pagerduty_team", "teams.write", "teams"); err != nil {
+		t.Errorf("want no error; got %v", err)
+	}
+	if err := c.Check("pagerduty_team", "teams.write", "teams", "team_hierarchy"); err == nil || !strings.Contains(err.Error(), `"team_hierarchy" ability`) {
+		t.Errorf("want missing team_hierarchy ability; got %v", err)
+	}
+
+	c.SetScopes([]string{"teams.read"})
+	if err := c.Check("pagerduty_team", "teams.write", "teams"); err == nil || !strings.Contains(err.Error(), `"teams.write" OAuth scope`) {
+		t.Errorf("want missing teams.write scope; got %v", err)
+	}
+}
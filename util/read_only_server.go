@@ -0,0 +1,126 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NewReadOnlyProviderServer wraps the provider server so that, when the
+// provider is configured with `read_only = true`, changes to resources are
+// refused before any of their requests is sent. Terraform reports the error
+// on the address of the resource being applied.
+func NewReadOnlyProviderServer(next tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return &readOnlyProviderServer{ProviderServer: next}
+}
+
+type readOnlyProviderServer struct {
+	tfprotov5.ProviderServer
+	readOnly bool
+}
+
+func (s *readOnlyProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	s.readOnly = s.configuredReadOnly(ctx, req.Config)
+	return s.ProviderServer.ConfigureProvider(ctx, req)
+}
+
+func (s *readOnlyProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if !s.readOnly {
+		return s.ProviderServer.ApplyResourceChange(ctx, req)
+	}
+
+	detail := fmt.Sprintf("The %s can't be changed", req.TypeName)
+	if schemas, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{}); err == nil && schemas.ResourceSchemas[req.TypeName] != nil {
+		schema := schemas.ResourceSchemas[req.TypeName]
+		prior, hasPrior := dynamicValueAttributes(req.PriorState, schema)
+		_, hasPlanned := dynamicValueAttributes(req.PlannedState, schema)
+
+		name := req.TypeName
+		var id string
+		if v, ok := prior["id"]; ok && v.IsKnown() && !v.IsNull() && v.As(&id) == nil && id != "" {
+			name = fmt.Sprintf("%s %s", req.TypeName, id)
+		}
+		switch {
+		case !hasPrior:
+			detail = fmt.Sprintf("The %s can't be created", name)
+		case !hasPlanned:
+			detail = fmt.Sprintf("The %s can't be destroyed", name)
+		default:
+			detail = fmt.Sprintf("The %s can't be updated", name)
+		}
+	}
+
+	return &tfprotov5.ApplyResourceChangeResponse{
+		NewState: req.PriorState,
+		Diagnostics: []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "The PagerDuty provider is configured with read_only = true",
+			Detail:   fmt.Sprintf("%s, no request was sent to PagerDuty.\n\n%s", detail, readOnlyHint),
+		}},
+	}, nil
+}
+
+// GetFunctions and CallFunction keep the provider server a function server.
+func (s *readOnlyProviderServer) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	if functionServer, ok := s.ProviderServer.(tfprotov5.FunctionServer); ok {
+		return functionServer.GetFunctions(ctx, req)
+	}
+	return &tfprotov5.GetFunctionsResponse{Functions: map[string]*tfprotov5.Function{}}, nil
+}
+
+func (s *readOnlyProviderServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	if functionServer, ok := s.ProviderServer.(tfprotov5.FunctionServer); ok {
+		return functionServer.CallFunction(ctx, req)
+	}
+	return &tfprotov5.CallFunctionResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("Function %s is not supported", req.Name),
+		}},
+	}, nil
+}
+
+// configuredReadOnly tells whether the provider is configured with
+// `read_only = true`, or with PAGERDUTY_READ_ONLY when it isn't set.
+func (s *readOnlyProviderServer) configuredReadOnly(ctx context.Context, config *tfprotov5.DynamicValue) bool {
+	envReadOnly, _ := strconv.ParseBool(os.Getenv("PAGERDUTY_READ_ONLY"))
+	if config == nil {
+		return envReadOnly
+	}
+
+	schemas, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil || schemas.Provider == nil {
+		return envReadOnly
+	}
+	attributes, ok := dynamicValueAttributes(config, schemas.Provider)
+	if !ok {
+		return envReadOnly
+	}
+
+	var readOnly bool
+	if v, ok := attributes["read_only"]; !ok || !v.IsKnown() || v.IsNull() || v.As(&readOnly) != nil {
+		return envReadOnly
+	}
+	return readOnly
+}
+
+// dynamicValueAttributes decodes the attributes of an object, reporting false
+// when it is null.
+func dynamicValueAttributes(value *tfprotov5.DynamicValue, schema *tfprotov5.Schema) (map[string]tftypes.Value, bool) {
+	if value == nil {
+		return nil, false
+	}
+	v, err := value.Unmarshal(schema.ValueType())
+	if err != nil || v.IsNull() || !v.IsKnown() {
+		return nil, false
+	}
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return nil, false
+	}
+	return attributes, true
+}
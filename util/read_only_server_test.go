@@ -0,0 +1,154 @@
+package util
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testApplyProviderServer struct {
+	tfprotov5.ProviderServer
+	applied bool
+}
+
+var testProviderSchema = &tfprotov5.Schema{
+	Block: &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "read_only", Type: tftypes.Bool, Optional: true},
+		},
+	},
+}
+
+var testTeamSchema = &tfprotov5.Schema{
+	Block: &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "id", Type: tftypes.String, Computed: true},
+			{Name: "name", Type: tftypes.String, Required: true},
+		},
+	},
+}
+
+func (s *testApplyProviderServer) GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		Provider:        testProviderSchema,
+		ResourceSchemas: map[string]*tfprotov5.Schema{"pagerduty_team": testTeamSchema},
+	}, nil
+}
+
+func (s *testApplyProviderServer) ConfigureProvider(context.Context, *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return &tfprotov5.ConfigureProviderResponse{}, nil
+}
+
+func (s *testApplyProviderServer) ApplyResourceChange(context.Context, *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	s.applied = true
+	return &tfprotov5.ApplyResourceChangeResponse{}, nil
+}
+
+func TestReadOnlyProviderServer(t *testing.T) {
+	dynamicValue := func(t *testing.T, schema *tfprotov5.Schema, v map[string]tftypes.Value) *tfprotov5.DynamicValue {
+		typ := schema.ValueType()
+		value := tftypes.NewValue(typ, nil)
+		if v != nil {
+			value = tftypes.NewValue(typ, v)
+		}
+		dv, err := tfprotov5.NewDynamicValue(typ, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &dv
+	}
+	config := func(readOnly interface{}) map[string]tftypes.Value {
+		return map[string]tftypes.Value{"read_only": tftypes.NewValue(tftypes.Bool, readOnly)}
+	}
+	team := map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "PTEAM01"),
+		"name": tftypes.NewValue(tftypes.String, "Engineering"),
+	}
+
+	cases := []struct {
+		name    string
+		config  map[string]tftypes.Value
+		env     string
+		prior   map[string]tftypes.Value
+		planned map[string]tftypes.Value
+		detail  string
+	}{
+		{
+			name:    "not read only",
+			config:  config(false),
+			prior:   team,
+			planned: team,
+		},
+		{
+			name:    "update",
+			config:  config(true),
+			prior:   team,
+			planned: team,
+			detail:  "The pagerduty_team PTEAM01 can't be updated",
+		},
+		{
+			name:    "create",
+			config:  config(true),
+			planned: team,
+			detail:  "The pagerduty_team can't be created",
+		},
+		{
+			name:   "destroy",
+			config: config(true),
+			prior:  team,
+			detail: "The pagerduty_team PTEAM01 can't be destroyed",
+		},
+		{
+			name:    "read only from the environment",
+			config:  config(nil),
+			env:     "true",
+			prior:   team,
+			planned: team,
+			detail:  "The pagerduty_team PTEAM01 can't be updated",
+		},
+		{
+			name:    "configuration overrides the environment",
+			config:  config(false),
+			env:     "true",
+			prior:   team,
+			planned: team,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("PAGERDUTY_READ_ONLY", c.env)
+
+			next := &testApplyProviderServer{}
+			s := NewReadOnlyProviderServer(next)
+			if _, err := s.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+				Config: dynamicValue(t, testProviderSchema, c.config),
+			}); err != nil {
+				t.Fatal(err)
+			}
+			resp, err := s.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{
+				TypeName:     "pagerduty_team",
+				PriorState:   dynamicValue(t, testTeamSchema, c.prior),
+				PlannedState: dynamicValue(t, testTeamSchema, c.planned),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if c.detail == "" {
+				if !next.applied || len(resp.Diagnostics) > 0 {
+					t.Errorf("expected the change to be applied, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if next.applied {
+				t.Error("expected the change to be refused before being applied")
+			}
+			if len(resp.Diagnostics) != 1 || !strings.HasPrefix(resp.Diagnostics[0].Detail, c.detail) || !strings.Contains(resp.Diagnostics[0].Detail, "Unset read_only") {
+				t.Errorf("expected a diagnostic starting with %q, got %v", c.detail, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+)
+
+// ReadOnlyErrorCode is the error code set in the body of the responses
+// synthesized by the read only transport, it doesn't collide with any error
+// code of PagerDuty's API.
+const ReadOnlyErrorCode = 9001
+
+// readOnlyErrorMessagePrefix starts the message of every request rejected by
+// the read only transport.
+const readOnlyErrorMessagePrefix = "The PagerDuty provider is configured with read_only = true, refusing to send"
+
+// readOnlyHint tells how to let the provider change PagerDuty again.
+const readOnlyHint = "Unset read_only in the provider configuration, and PAGERDUTY_READ_ONLY in the environment, to apply changes to PagerDuty."
+
+// NewReadOnlyTransport returns an http.RoundTripper which only lets through
+// GET, HEAD and OPTIONS requests. Any other request is never sent to
+// PagerDuty, instead a "400 Bad Request" response with ReadOnlyErrorCode is
+// synthesized. Resources retrying bad requests would retry it until they time
+// out, so applies are refused before their first request by
+// NewReadOnlyProviderServer, this only guards requests made outside of them.
+func NewReadOnlyTransport(next http.RoundTripper) http.RoundTripper {
+	return &readOnlyTransport{next: next}
+}
+
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(req)
+	}
+
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	message := readOnlyErrorMessage(req.Method, req.URL.Path)
+	log.Printf("[WARN] %s", message)
+
+	body, err := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"code":    ReadOnlyErrorCode,
+			"message": message,
+			"errors":  []string{message},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        "400 Bad Request",
+		StatusCode:    http.StatusBadRequest,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// readOnlyErrorMessage describes a request rejected because the provider is
+// configured with `read_only = true`.
+func readOnlyErrorMessage(method, path string) string {
+	return fmt.Sprintf("%s %s %s", readOnlyErrorMessagePrefix, method, path)
+}
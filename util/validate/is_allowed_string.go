@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"context"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type isAllowedString struct {
+	mode util.StringContentValidationMode
+}
+
+var _ validator.String = (*isAllowedString)(nil)
+
+func (v *isAllowedString) Description(context.Context) string {
+	return "Validates that the value is not blank, doesn't end with a white space and has no disallowed characters."
+}
+
+func (v *isAllowedString) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v *isAllowedString) ValidateString(_ context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, d := range util.ValidateIsAllowedString(v.mode)(req.ConfigValue.ValueString(), nil) {
+		res.Diagnostics.AddAttributeError(req.Path, "Invalid Value", d.Summary)
+	}
+}
+
+// IsAllowedString returns a Framework validator equivalent to
+// util.ValidateIsAllowedString.
+func IsAllowedString(mode util.StringContentValidationMode) validator.String {
+	return &isAllowedString{mode: mode}
+}